@@ -0,0 +1,38 @@
+package ginerr
+
+import (
+	"net/http"
+	"time"
+)
+
+// AcceptedResponse is the response body for asynchronous operations that are still being processed,
+// it tells the client where it can poll for the result and how long it should wait before doing so.
+type AcceptedResponse struct {
+	// Location is the URL where the status of the operation can be polled
+	Location string `json:"location"`
+
+	// RetryAfter is the amount of seconds the client should wait before polling again
+	RetryAfter int `json:"retryAfter,omitempty"`
+}
+
+// Accepted can be returned from error handlers for errors that indicate an operation is still processing
+// (e.g. ErrStillProcessing), it returns a 202 Accepted status with polling information.
+func Accepted(location string, retryAfter time.Duration) (int, any) {
+	return http.StatusAccepted, &AcceptedResponse{
+		Location:   location,
+		RetryAfter: int(retryAfter.Round(time.Second) / time.Second),
+	}
+}
+
+// AcceptedWithHeaders is like Accepted, but also returns the Location and Retry-After headers, for use with
+// RegisterErrorHandlerWithHeadersOn.
+func AcceptedWithHeaders(location string, retryAfter time.Duration) (int, any, http.Header) {
+	code, response := Accepted(location, retryAfter)
+
+	headers := http.Header{"Location": []string{location}}
+	if retryAfter > 0 {
+		headers.Set("Retry-After", FormatRetryAfter(retryAfter))
+	}
+
+	return code, response, headers
+}
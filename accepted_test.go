@@ -0,0 +1,59 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stillProcessingError struct {
+	statusURL string
+}
+
+func (e *stillProcessingError) Error() string {
+	return "still processing"
+}
+
+func TestAccepted_ReturnsAcceptedResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	callback := func(_ context.Context, err *stillProcessingError) (int, any) {
+		return Accepted(err.statusURL, 1500*time.Millisecond)
+	}
+
+	RegisterErrorHandlerOn(registry, &stillProcessingError{}, callback)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, &stillProcessingError{statusURL: "/jobs/123"})
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, code)
+	assert.Equal(t, &AcceptedResponse{Location: "/jobs/123", RetryAfter: 2}, response)
+}
+
+func TestAcceptedWithHeaders_ReturnsLocationAndRetryAfter(t *testing.T) {
+	t.Parallel()
+	// Act
+	code, response, headers := AcceptedWithHeaders("/jobs/123", 1500*time.Millisecond)
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, code)
+	assert.Equal(t, &AcceptedResponse{Location: "/jobs/123", RetryAfter: 2}, response)
+	assert.Equal(t, "/jobs/123", headers.Get("Location"))
+	assert.Equal(t, "2", headers.Get("Retry-After"))
+}
+
+func TestAcceptedWithHeaders_OmitsRetryAfterOnZero(t *testing.T) {
+	t.Parallel()
+	// Act
+	_, _, headers := AcceptedWithHeaders("/jobs/123", 0)
+
+	// Assert
+	assert.Equal(t, "/jobs/123", headers.Get("Location"))
+	assert.NotContains(t, headers, "Retry-After")
+}
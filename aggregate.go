@@ -0,0 +1,54 @@
+package ginerr
+
+import "context"
+
+// aggregateHandlerName is the HandlerName of responses of the aggregate handler, see SetAggregateHandler
+const aggregateHandlerName = "aggregate"
+
+// multiError is implemented by errors that aggregate other errors, like those of errors.Join, multierr and
+// go-multierror
+type multiError interface {
+	error
+	Unwrap() []error
+}
+
+// SetAggregateHandler sets a handler for errors that aggregate other errors by implementing `Unwrap() []error`, like
+// those of errors.Join or a list of validation errors. Every element of the first aggregate error in the tree is
+// resolved separately, without the aggregate handler, and the handler composes one response from the results, which
+// are in the order of the elements. Unlike JoinAggregate, which combines the handlers that match anywhere in the
+// tree, every element gets a result, including the default response for elements no handler matched. Nil disables
+// it, which is the default.
+func (e *ErrorRegistry) SetAggregateHandler(handler func(ctx context.Context, err error, results []ErrorResponse) (int, any)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.aggregateHandler = handler
+}
+
+// resolveAggregate resolves every element of an aggregate error and calls the aggregate handler with the results,
+// the boolean is false if there's no aggregate handler or the error doesn't aggregate other errors.
+func (e *ErrorRegistry) resolveAggregate(ctx context.Context, err error) (ErrorResponse, bool) {
+	e.mu.RLock()
+	handler := e.aggregateHandler
+	e.mu.RUnlock()
+
+	var aggregate multiError
+	if handler == nil || !errorsAs(err, &aggregate) {
+		return ErrorResponse{}, false
+	}
+
+	elements := aggregate.Unwrap()
+	results := make([]ErrorResponse, 0, len(elements))
+
+	for _, element := range elements {
+		if element == nil {
+			continue
+		}
+
+		results = append(results, e.resolveError(ctx, element))
+	}
+
+	code, response := handler(ctx, err, results)
+
+	return newErrorResponse(code, response, nil, aggregateHandlerName), true
+}
@@ -0,0 +1,77 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorRegistry_SetAggregateHandler_ResolvesEveryElement(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, err.message
+	})
+
+	var calledWith []ErrorResponse
+	registry.SetAggregateHandler(func(_ context.Context, _ error, results []ErrorResponse) (int, any) {
+		calledWith = results
+
+		return http.StatusUnprocessableEntity, len(results)
+	})
+
+	err := fmt.Errorf("validating: %w", errors.Join(&AError{message: "iban"}, assert.AnError, &AError{message: "amount"}))
+
+	// Act
+	result := ResolveFrom(context.Background(), registry, err)
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, result.Code)
+	assert.Equal(t, 3, result.Body)
+	assert.Equal(t, "aggregate", result.HandlerName)
+
+	require.Len(t, calledWith, 3)
+	assert.Equal(t, "iban", calledWith[0].Body)
+	assert.Equal(t, http.StatusInternalServerError, calledWith[1].Code)
+	assert.Equal(t, "amount", calledWith[2].Body)
+}
+
+func TestErrorRegistry_SetAggregateHandler_IgnoresOtherErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	registry.SetAggregateHandler(func(context.Context, error, []ErrorResponse) (int, any) {
+		return http.StatusUnprocessableEntity, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", assert.AnError))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestErrorRegistry_SetAggregateHandler_StopsOnAggregateLoops(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	registry.SetAggregateHandler(func(_ context.Context, _ error, results []ErrorResponse) (int, any) {
+		return http.StatusUnprocessableEntity, len(results)
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, valueLoopError{})
+
+	// Assert
+	assert.Equal(t, http.StatusUnprocessableEntity, code)
+	assert.Equal(t, 2, response)
+}
@@ -0,0 +1,119 @@
+package ginerr
+
+import (
+	"context"
+	"maps"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiVersionGinKey is the key under which APIVersion stores the version in the gin context
+const apiVersionGinKey = "ginerr.apiVersion"
+
+// apiVersionContextKey is the context key under which the API version is stored
+type apiVersionContextKey struct{}
+
+// WithAPIVersion returns a copy of the context with the given API version, for requests that aren't served by gin,
+// see ForAPIVersion.
+func WithAPIVersion(ctx context.Context, version string) context.Context {
+	return context.WithValue(ctx, apiVersionContextKey{}, version)
+}
+
+// APIVersion returns a gin middleware that sets the API version of the requests it serves, like
+// `engine.Group("/v2", ginerr.APIVersion("v2"))`, see ForAPIVersion.
+func APIVersion(version string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(apiVersionGinKey, version)
+	}
+}
+
+// APIVersionFromContext returns the API version set by WithAPIVersion or APIVersion, the boolean is false if none
+// was set.
+func APIVersionFromContext(ctx context.Context) (string, bool) {
+	if version, ok := ctx.Value(apiVersionContextKey{}).(string); ok {
+		return version, true
+	}
+
+	c := ginContextFrom(ctx)
+	if c == nil {
+		return "", false
+	}
+
+	version, ok := c.Get(apiVersionGinKey)
+	if !ok {
+		return "", false
+	}
+
+	versionString, ok := version.(string)
+
+	return versionString, ok
+}
+
+// ForAPIVersion makes the registration a variant for the given API version of the registration for the same
+// instance or type, so /v1 and /v2 of an API can return different response shapes from one registry. The variant
+// is called if the API version of the context matches (see APIVersion and WithAPIVersion), otherwise the
+// registration without a version is called. If there is none, resolution continues with the next match like
+// ErrSkip. Errors are matched using the first registration for the error.
+func ForAPIVersion(version string) RegistrationOption {
+	return func(handler *errorHandler) {
+		handler.apiVersion = version
+	}
+}
+
+// withVariant returns the registration that is stored after registering handler on top of previous, which is nil
+// if there was no registration for the error yet. Handlers are called without holding the lock, so variants are
+// copied instead of modified.
+func withVariant(previous *errorHandler, handler *errorHandler) *errorHandler {
+	if handler.apiVersion == "" {
+		if previous != nil {
+			handler.variants = previous.variants
+		}
+
+		return handler
+	}
+
+	var base errorHandler
+	if previous != nil {
+		base = *previous
+	} else {
+		// It declines errors of other versions, as there is no handler for them
+		base = *handler
+		base.apiVersion = ""
+		base.placeholder = true
+		base.handle = func(context.Context, error) (int, any, http.Header) {
+			return 0, ErrSkip, nil
+		}
+
+		// It would be preferred over handle, see handlerMatch.call
+		base.handleOriginal = nil
+	}
+
+	base.variants = maps.Clone(base.variants)
+	if base.variants == nil {
+		base.variants = make(map[string]*errorHandler, 1)
+	}
+
+	base.variants[handler.apiVersion] = handler
+
+	return &base
+}
+
+// forVersion returns the variant of the handler for the API version of the context, or the handler itself if
+// there is none.
+func (h *errorHandler) forVersion(ctx context.Context) *errorHandler {
+	if len(h.variants) == 0 {
+		return h
+	}
+
+	version, ok := APIVersionFromContext(ctx)
+	if !ok {
+		return h
+	}
+
+	if variant, ok := h.variants[version]; ok {
+		return variant
+	}
+
+	return h
+}
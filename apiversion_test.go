@@ -0,0 +1,119 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForAPIVersion_UsesVariantOfContextVersion(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	instance := &AError{}
+
+	RegisterErrorHandlerOn(registry, instance, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, err.message
+	})
+	RegisterErrorHandlerOn(registry, instance, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, map[string]string{"message": err.message}
+	}, ForAPIVersion("v2"))
+
+	tests := map[string]struct {
+		ctx              context.Context
+		expectedResponse any
+	}{
+		"no version": {
+			ctx:              context.Background(),
+			expectedResponse: "invalid",
+		},
+		"version without variant": {
+			ctx:              WithAPIVersion(context.Background(), "v1"),
+			expectedResponse: "invalid",
+		},
+		"version with variant": {
+			ctx:              WithAPIVersion(context.Background(), "v2"),
+			expectedResponse: map[string]string{"message": "invalid"},
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(testData.ctx, registry, &AError{message: "invalid"})
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestForAPIVersion_KeepsVariantsWhenReplacingHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusGone, nil
+	}, ForAPIVersion("v2"))
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	v1Code, _ := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v1"), registry, &AError{})
+	v2Code, _ := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v2"), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, v1Code)
+	assert.Equal(t, http.StatusGone, v2Code)
+	assert.Len(t, registry.Rules(), 1)
+}
+
+func TestForAPIVersion_SkipsWithoutHandlerForVersion(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	}, ForAPIVersion("v2"))
+
+	// Act
+	v1Code, _ := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v1"), registry, &AError{})
+	v2Code, _ := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v2"), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, v1Code)
+	assert.Equal(t, http.StatusBadRequest, v2Code)
+}
+
+func TestAPIVersion_SetsVersionOfGinRequests(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "v1"
+	})
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "v2"
+	}, ForAPIVersion("v2"))
+
+	engine := newTestEngine(APIVersion("v2"), WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{}
+	}))
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `"v2"`, recorder.Body.String())
+}
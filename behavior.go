@@ -0,0 +1,71 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+)
+
+// behaviorKey is the key of handlers registered for a behavior method, like Timeout() bool
+type behaviorKey struct {
+	method string
+}
+
+func (b behaviorKey) Error() string {
+	return "behavior " + b.method + "()"
+}
+
+// timeoutBehavior is implemented by errors that report whether they're a timeout, like net.Error
+type timeoutBehavior interface {
+	Timeout() bool
+}
+
+// temporaryBehavior is implemented by errors that report whether they're temporary, like some net and client errors
+type temporaryBehavior interface {
+	Temporary() bool
+}
+
+// RegisterTimeoutHandler registers an error handler for timeouts in DefaultErrorRegistry.
+func RegisterTimeoutHandler(handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterTimeoutHandlerOn(DefaultErrorRegistry, handler, options...)
+}
+
+// RegisterTimeoutHandlerOn registers an error handler in the given registry for errors whose Timeout method returns
+// true, which many net and client libraries expose instead of concrete errors. Unlike RegisterInterfaceOn, errors
+// that implement Timeout but return false don't match. The handler receives the error that reported the timeout.
+func RegisterTimeoutHandlerOn(registry *ErrorRegistry, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	matches := func(err error) bool {
+		timeoutErr, ok := err.(timeoutBehavior)
+
+		return ok && timeoutErr.Timeout()
+	}
+
+	registry.addHandler(behaviorKey{method: "Timeout"}, newMatcherHandler(matches, handler, options))
+}
+
+// RegisterTemporaryHandler registers an error handler for temporary errors in DefaultErrorRegistry.
+func RegisterTemporaryHandler(handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterTemporaryHandlerOn(DefaultErrorRegistry, handler, options...)
+}
+
+// RegisterTemporaryHandlerOn registers an error handler in the given registry for errors whose Temporary method
+// returns true, see RegisterTimeoutHandlerOn. The handler receives the error that reported being temporary.
+func RegisterTemporaryHandlerOn(registry *ErrorRegistry, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	matches := func(err error) bool {
+		temporaryErr, ok := err.(temporaryBehavior)
+
+		return ok && temporaryErr.Temporary()
+	}
+
+	registry.addHandler(behaviorKey{method: "Temporary"}, newMatcherHandler(matches, handler, options))
+}
+
+// UseDefaultBehaviorHandlers registers handlers for timeouts and temporary errors in the given registry, timeouts
+// become 504 Gateway Timeout and temporary errors 503 Service Unavailable. Errors that report both are timeouts.
+func UseDefaultBehaviorHandlers(registry *ErrorRegistry) {
+	RegisterTimeoutHandlerOn(registry, func(context.Context, error) (int, any) {
+		return http.StatusGatewayTimeout, nil
+	})
+	RegisterTemporaryHandlerOn(registry, func(context.Context, error) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+}
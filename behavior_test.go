@@ -0,0 +1,71 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUseDefaultBehaviorHandlers_MapsBehaviors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	UseDefaultBehaviorHandlers(registry)
+
+	tests := map[string]struct {
+		err          error
+		expectedCode int
+	}{
+		"timeout": {
+			err:          fmt.Errorf("resolving: %w", &net.DNSError{IsTimeout: true}),
+			expectedCode: http.StatusGatewayTimeout,
+		},
+		"temporary": {
+			err:          &net.DNSError{IsTemporary: true},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		"temporary interface": {
+			err:          dummyTemporaryError{},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		"neither": {
+			err:          &net.DNSError{IsNotFound: true},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
+
+func TestRegisterTimeoutHandlerOn_ReplacesDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	UseDefaultBehaviorHandlers(registry)
+
+	RegisterTimeoutHandlerOn(registry, func(_ context.Context, err error) (int, any) {
+		return http.StatusRequestTimeout, fmt.Sprintf("%T", err)
+	})
+
+	// Act
+	result := ResolveFrom(context.Background(), registry, fmt.Errorf("resolving: %w", &net.DNSError{IsTimeout: true}))
+
+	// Assert
+	assert.Equal(t, http.StatusRequestTimeout, result.Code)
+	assert.Equal(t, "*net.DNSError", result.Body)
+	assert.Equal(t, "behavior Timeout()", result.HandlerName)
+	assert.Len(t, registry.Rules(), 2)
+}
@@ -0,0 +1,144 @@
+package ginerr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+	"github.com/ing-bank/ginerr/v3/respond"
+)
+
+// BindingError is returned by ShouldBind and friends if the request couldn't be bound, it wraps the error of gin's
+// binding. It marks the error as the fault of the client, as errors like io.EOF and *strconv.NumError are also
+// returned by code that has nothing to do with the request.
+type BindingError struct {
+	Err error
+}
+
+func (e *BindingError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *BindingError) Unwrap() error {
+	return e.Err
+}
+
+// ShouldBind is like c.ShouldBind, but wraps errors in a *BindingError, see UseDefaultBindingHandlers.
+func ShouldBind(c *gin.Context, obj any) error {
+	return wrapBindingError(c.ShouldBind(obj))
+}
+
+// ShouldBindJSON is like c.ShouldBindJSON, but wraps errors in a *BindingError, see UseDefaultBindingHandlers.
+func ShouldBindJSON(c *gin.Context, obj any) error {
+	return wrapBindingError(c.ShouldBindJSON(obj))
+}
+
+// ShouldBindQuery is like c.ShouldBindQuery, but wraps errors in a *BindingError, see UseDefaultBindingHandlers.
+func ShouldBindQuery(c *gin.Context, obj any) error {
+	return wrapBindingError(c.ShouldBindQuery(obj))
+}
+
+// ShouldBindURI is like c.ShouldBindUri, but wraps errors in a *BindingError, see UseDefaultBindingHandlers.
+func ShouldBindURI(c *gin.Context, obj any) error {
+	return wrapBindingError(c.ShouldBindUri(obj))
+}
+
+// ShouldBindWith is like c.ShouldBindWith, but wraps errors in a *BindingError, see UseDefaultBindingHandlers.
+func ShouldBindWith(c *gin.Context, obj any, b binding.Binding) error {
+	return wrapBindingError(c.ShouldBindWith(obj, b))
+}
+
+// wrapBindingError wraps the error in a *BindingError, nil stays nil.
+func wrapBindingError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &BindingError{Err: err}
+}
+
+// UseDefaultBindingHandlers registers a handler for *BindingError in the given registry, which is returned by
+// ShouldBind, ShouldBindJSON, ShouldBindQuery, ShouldBindURI and ShouldBindWith. All of them result in a 400 Bad
+// Request with a respond.Error body that describes what was wrong with the request.
+//
+// The validator.ValidationErrors, *json.SyntaxError and *json.UnmarshalTypeError returned by gin's own binding
+// methods, like c.ShouldBindJSON, are handled as well. Other errors of gin's binding, like io.EOF and
+// *strconv.NumError, are only handled when wrapped by the functions above, as they can't be told apart from the same
+// errors returned elsewhere in the application.
+func UseDefaultBindingHandlers(registry *ErrorRegistry) {
+	RegisterErrorHandlerOn(registry, &BindingError{}, func(_ context.Context, err *BindingError) (int, any) {
+		return bindingResponse(err.Err)
+	})
+
+	RegisterTypeOn(registry, func(_ context.Context, err validator.ValidationErrors) (int, any) {
+		return bindingResponse(err)
+	})
+	RegisterTypeOn(registry, func(_ context.Context, err *json.SyntaxError) (int, any) {
+		return bindingResponse(err)
+	})
+	RegisterTypeOn(registry, func(_ context.Context, err *json.UnmarshalTypeError) (int, any) {
+		return bindingResponse(err)
+	})
+}
+
+// bindingResponse returns the 400 Bad Request response for an error of gin's binding.
+func bindingResponse(err error) (int, any) {
+	var (
+		syntaxErr      *json.SyntaxError
+		unmarshalErr   *json.UnmarshalTypeError
+		numErr         *strconv.NumError
+		parseErr       *time.ParseError
+		validationErrs validator.ValidationErrors
+	)
+
+	switch {
+	case errorsIs(err, io.EOF):
+		return respond.BadRequest("request body is empty")
+
+	case errorsIs(err, io.ErrUnexpectedEOF):
+		return respond.BadRequest("request body contains malformed JSON")
+
+	case errorsAs(err, &syntaxErr):
+		return respond.BadRequest("request body contains malformed JSON at position %d", syntaxErr.Offset)
+
+	case errorsAs(err, &unmarshalErr):
+		if unmarshalErr.Field == "" {
+			return respond.BadRequest("request body must be of type %s", unmarshalErr.Type)
+		}
+
+		return respond.FieldError(unmarshalErr.Field, "must be of type "+unmarshalErr.Type.String())
+
+	case errorsAs(err, &numErr):
+		return respond.BadRequest("invalid number %q", numErr.Num)
+
+	case errorsAs(err, &parseErr):
+		return respond.BadRequest("invalid time %q", parseErr.Value)
+
+	case errorsAs(err, &validationErrs):
+		fields := make([]respond.Field, 0, len(validationErrs))
+
+		for _, fieldErr := range validationErrs {
+			fields = append(fields, respond.Field{Name: fieldErr.Field(), Message: validationMessage(fieldErr)})
+		}
+
+		return respond.FieldErrors(fields...)
+
+	default:
+		return respond.BadRequest("invalid request")
+	}
+}
+
+// validationMessage describes why a field failed validation, without exposing its value.
+func validationMessage(fieldErr validator.FieldError) string {
+	if fieldErr.Param() == "" {
+		return fmt.Sprintf("failed on the '%s' validation", fieldErr.Tag())
+	}
+
+	return fmt.Sprintf("failed on the '%s=%s' validation", fieldErr.Tag(), fieldErr.Param())
+}
@@ -0,0 +1,215 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ing-bank/ginerr/v3/respond"
+	"github.com/stretchr/testify/assert"
+)
+
+type bindingTestInput struct {
+	Amount   int    `json:"amount" form:"amount" binding:"required,min=1"`
+	Currency string `json:"currency" form:"currency" binding:"required"`
+}
+
+func TestUseDefaultBindingHandlers_ReturnsBadRequestOnBindingErrors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		body     string
+		query    string
+		expected any
+	}{
+		"empty body": {
+			body:     "",
+			expected: &respond.Error{Message: "request body is empty"},
+		},
+		"syntax error": {
+			body:     `{"amount": }`,
+			expected: &respond.Error{Message: "request body contains malformed JSON at position 12"},
+		},
+		"unexpected end": {
+			body:     `{"amount": 1`,
+			expected: &respond.Error{Message: "request body contains malformed JSON"},
+		},
+		"type error": {
+			body: `{"amount": "abc", "currency": "EUR"}`,
+			expected: &respond.Error{
+				Message: "invalid input",
+				Fields:  []respond.Field{{Name: "amount", Message: "must be of type int"}},
+			},
+		},
+		"top level type error": {
+			body:     `[]`,
+			expected: &respond.Error{Message: "request body must be of type ginerr.bindingTestInput"},
+		},
+		"validation error": {
+			body: `{"amount": 0}`,
+			expected: &respond.Error{
+				Message: "invalid input",
+				Fields: []respond.Field{
+					{Name: "Amount", Message: "failed on the 'required' validation"},
+					{Name: "Currency", Message: "failed on the 'required' validation"},
+				},
+			},
+		},
+		"validation error with param": {
+			body: `{"amount": -1, "currency": "EUR"}`,
+			expected: &respond.Error{
+				Message: "invalid input",
+				Fields:  []respond.Field{{Name: "Amount", Message: "failed on the 'min=1' validation"}},
+			},
+		},
+		"query number error": {
+			query:    "?amount=abc&currency=EUR",
+			expected: &respond.Error{Message: `invalid number "abc"`},
+		},
+	}
+
+	registry := NewErrorRegistry()
+	UseDefaultBindingHandlers(registry)
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/"+testData.query, strings.NewReader(testData.body))
+
+			var input bindingTestInput
+
+			var err error
+			if testData.query != "" {
+				err = ShouldBindQuery(c, &input)
+			} else {
+				err = ShouldBindJSON(c, &input)
+			}
+
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, err)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, testData.expected, response)
+		})
+	}
+}
+
+func TestUseDefaultBindingHandlers_ReturnsBadRequestOnErrorsOfGinBinding(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		body     string
+		query    string
+		expected any
+	}{
+		"syntax error": {
+			body:     `{"amount": }`,
+			expected: &respond.Error{Message: "request body contains malformed JSON at position 12"},
+		},
+		"type error": {
+			body: `{"amount": "abc", "currency": "EUR"}`,
+			expected: &respond.Error{
+				Message: "invalid input",
+				Fields:  []respond.Field{{Name: "amount", Message: "must be of type int"}},
+			},
+		},
+		"validation error": {
+			body: `{"amount": -1, "currency": "EUR"}`,
+			expected: &respond.Error{
+				Message: "invalid input",
+				Fields:  []respond.Field{{Name: "Amount", Message: "failed on the 'min=1' validation"}},
+			},
+		},
+		"query validation error": {
+			query: "?amount=1",
+			expected: &respond.Error{
+				Message: "invalid input",
+				Fields:  []respond.Field{{Name: "Currency", Message: "failed on the 'required' validation"}},
+			},
+		},
+	}
+
+	registry := NewErrorRegistry()
+	UseDefaultBindingHandlers(registry)
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			gin.SetMode(gin.TestMode)
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			c.Request = httptest.NewRequest(http.MethodPost, "/"+testData.query, strings.NewReader(testData.body))
+
+			var input bindingTestInput
+
+			var err error
+			if testData.query != "" {
+				err = c.ShouldBindQuery(&input)
+			} else {
+				err = c.ShouldBindJSON(&input)
+			}
+
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, err)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, code)
+			assert.Equal(t, testData.expected, response)
+		})
+	}
+}
+
+func TestUseDefaultBindingHandlers_IgnoresErrorsOutsideBinding(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	UseDefaultBindingHandlers(registry)
+
+	_, numErr := strconv.Atoi("abc")
+
+	tests := map[string]error{
+		"eof":            io.EOF,
+		"unexpected eof": fmt.Errorf("reading upstream: %w", io.ErrUnexpectedEOF),
+		"number":         numErr,
+	}
+
+	for name, err := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, err)
+
+			// Assert
+			assert.Equal(t, http.StatusInternalServerError, code)
+		})
+	}
+}
+
+func TestShouldBind_ReturnsNilOnValidInput(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	c.Request = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"amount": 1, "currency": "EUR"}`))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	var input bindingTestInput
+
+	// Act
+	err := ShouldBind(c, &input)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, bindingTestInput{Amount: 1, Currency: "EUR"}, input)
+}
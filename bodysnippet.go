@@ -0,0 +1,62 @@
+package ginerr
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// bodySnippetContextKey is the context key under which the body snippet is stored
+type bodySnippetContextKey struct{}
+
+// bodySnippet records the first bytes of a request body while it's being read
+type bodySnippet struct {
+	io.ReadCloser
+
+	limit int
+	data  []byte
+}
+
+func (b *bodySnippet) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+
+	if remaining := b.limit - len(b.data); remaining > 0 {
+		b.data = append(b.data, p[:min(n, remaining)]...)
+	}
+
+	return n, err
+}
+
+// BodySnippetMiddleware retains the first limit bytes of every request body that is read by the next handler,
+// error handlers can retrieve them using BodySnippetFromContext to diagnose malformed payloads. The snippet
+// might contain sensitive data, so it should only be logged and never be sent back in a response.
+func BodySnippetMiddleware(limit int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if request.Body == nil || request.Body == http.NoBody {
+				next.ServeHTTP(writer, request)
+
+				return
+			}
+
+			snippet := &bodySnippet{ReadCloser: request.Body, limit: limit}
+
+			request = request.WithContext(context.WithValue(request.Context(), bodySnippetContextKey{}, snippet))
+			request.Body = snippet
+
+			next.ServeHTTP(writer, request)
+		})
+	}
+}
+
+// BodySnippetFromContext returns the part of the request body that was read so far, up to the limit
+// of BodySnippetMiddleware. The context may also be the *gin.Context of the request. It returns nil if the
+// middleware was not used.
+func BodySnippetFromContext(ctx context.Context) []byte {
+	snippet, ok := requestContextValue(ctx, bodySnippetContextKey{}).(*bodySnippet)
+	if !ok {
+		return nil
+	}
+
+	return snippet.data
+}
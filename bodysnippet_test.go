@@ -0,0 +1,106 @@
+package ginerr
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBodySnippetMiddleware_ExposesBodySnippetToHandlers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithSnippet []byte
+	RegisterErrorHandlerOn(registry, &AError{}, func(ctx context.Context, _ *AError) (int, any) {
+		calledWithSnippet = BodySnippetFromContext(ctx)
+		return http.StatusBadRequest, "invalid body"
+	})
+
+	var readBody string
+	handler := BodySnippetMiddleware(5)(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, err := io.ReadAll(request.Body)
+		require.NoError(t, err)
+		readBody = string(body)
+
+		code, _ := NewErrorResponseFrom(request.Context(), registry, &AError{})
+		writer.WriteHeader(code)
+	}))
+
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"amount": -1}`))
+	recorder := httptest.NewRecorder()
+
+	// Act
+	handler.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, `{"amount": -1}`, readBody)
+	assert.Equal(t, []byte(`{"amo`), calledWithSnippet)
+}
+
+func TestBodySnippetMiddleware_ExposesBodySnippetToGinHandlers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithSnippet []byte
+	RegisterErrorHandlerOn(registry, &AError{}, func(ctx context.Context, _ *AError) (int, any) {
+		calledWithSnippet = BodySnippetFromContext(ctx)
+		return http.StatusBadRequest, "invalid body"
+	})
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.POST("/", WrapHandlerFrom(registry, func(c *gin.Context) error {
+		_, err := io.ReadAll(c.Request.Body)
+		require.NoError(t, err)
+
+		return &AError{}
+	}))
+
+	handler := BodySnippetMiddleware(5)(engine)
+
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"amount": -1}`))
+	recorder := httptest.NewRecorder()
+
+	// Act
+	handler.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, []byte(`{"amo`), calledWithSnippet)
+}
+
+func TestBodySnippetMiddleware_IgnoresEmptyBodies(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var snippet []byte
+	handler := BodySnippetMiddleware(5)(http.HandlerFunc(func(_ http.ResponseWriter, request *http.Request) {
+		snippet = BodySnippetFromContext(request.Context())
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	handler.ServeHTTP(httptest.NewRecorder(), request)
+
+	// Assert
+	assert.Nil(t, snippet)
+}
+
+func TestBodySnippetFromContext_ReturnsNilOnNoMiddleware(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := BodySnippetFromContext(context.Background())
+
+	// Assert
+	assert.Nil(t, result)
+}
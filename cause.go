@@ -0,0 +1,49 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Cause describes a single error in the unwrap chain of an error.
+type Cause struct {
+	// Type is the Go type of the error, like *fs.PathError
+	Type string `json:"type"`
+
+	// Message is the result of the Error method of the error
+	Message string `json:"message"`
+}
+
+// Causes returns the unwrap chain of err in the same depth-first order errors.Is uses, starting with err itself.
+// Joined errors are flattened into the chain.
+func Causes(err error) []Cause {
+	var causes []Cause
+
+	walkErrors(err, maxUnwrapDepth, func(err error) bool {
+		causes = append(causes, Cause{Type: fmt.Sprintf("%T", err), Message: err.Error()})
+
+		return false
+	})
+
+	return causes
+}
+
+// DebugResponse is the response of DebugDefaultHandler in gin's debug mode.
+type DebugResponse struct {
+	// Causes is the unwrap chain of the error that couldn't be handled
+	Causes []Cause `json:"causes"`
+}
+
+// DebugDefaultHandler can be used as the default handler of a registry, in gin's debug mode it returns a
+// DebugResponse with the cause chain of the error so nested causes of a 500 can be inspected. Outside of debug
+// mode it returns no body, like the default handler of NewErrorRegistry.
+func DebugDefaultHandler(_ context.Context, err error) (int, any) {
+	if !gin.IsDebugging() {
+		return http.StatusInternalServerError, nil
+	}
+
+	return http.StatusInternalServerError, &DebugResponse{Causes: Causes(err)}
+}
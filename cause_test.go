@@ -0,0 +1,77 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCauses_ReturnsUnwrapChain(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	err := fmt.Errorf("loading user: %w", errors.Join(&AError{message: "a"}, &BError{message: "b"}))
+
+	// Act
+	result := Causes(err)
+
+	// Assert
+	expected := []Cause{
+		{Type: "*fmt.wrapError", Message: "loading user: a\nb"},
+		{Type: "*errors.joinError", Message: "a\nb"},
+		{Type: "*ginerr.AError", Message: "a"},
+		{Type: "*ginerr.BError", Message: "b"},
+	}
+
+	assert.Equal(t, expected, result)
+}
+
+func TestCauses_ReturnsNilOnNilError(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := Causes(nil)
+
+	// Assert
+	assert.Nil(t, result)
+}
+
+//nolint:paralleltest // Can't be used, we change the gin mode
+func TestDebugDefaultHandler_ReturnsCausesInDebugMode(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.DebugMode)
+	defer gin.SetMode(gin.TestMode)
+
+	registry := NewErrorRegistry()
+	registry.RegisterDefaultHandler(DebugDefaultHandler)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("query: %w", &AError{message: "a"}))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+
+	expected := &DebugResponse{Causes: []Cause{
+		{Type: "*fmt.wrapError", Message: "query: a"},
+		{Type: "*ginerr.AError", Message: "a"},
+	}}
+
+	assert.Equal(t, expected, response)
+}
+
+//nolint:paralleltest // Can't be used, we change the gin mode
+func TestDebugDefaultHandler_ReturnsNoBodyOutsideDebugMode(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.ReleaseMode)
+	defer gin.SetMode(gin.TestMode)
+
+	// Act
+	code, response := DebugDefaultHandler(context.Background(), &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+}
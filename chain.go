@@ -0,0 +1,43 @@
+package ginerr
+
+import (
+	"context"
+	"reflect"
+)
+
+// RegisterErrorChainHandler registers an error handler for the error type E in DefaultErrorRegistry that also
+// receives the unwrap chain of the resolved error, see RegisterErrorChainHandlerOn.
+func RegisterErrorChainHandler[E error](handler func(ctx context.Context, err E, chain []error) (int, any), options ...RegistrationOption) {
+	RegisterErrorChainHandlerOn(DefaultErrorRegistry, handler, options...)
+}
+
+// RegisterErrorChainHandlerOn registers an error handler for the error type E in the given registry like
+// RegisterTypeOn, but the handler also receives the unwrap chain of the resolved error from the outermost error to
+// the innermost one, in the same order as Causes. This way the response can contain the cause that matched, while
+// the entire chain can still be logged.
+func RegisterErrorChainHandlerOn[E error](registry *ErrorRegistry, handler func(ctx context.Context, err E, chain []error) (int, any), options ...RegistrationOption) {
+	var withChain func(context.Context, E, error) (int, any)
+	if handler != nil {
+		withChain = func(ctx context.Context, err E, original error) (int, any) {
+			return handler(ctx, err, errorChain(original))
+		}
+	}
+
+	errorHandler := newErrorHandler(false, withoutHeaders(withMatchedAsOriginal(withChain)), options)
+	errorHandler.handleOriginal = withOriginal(withChain)
+
+	registry.addHandler(typeKey{reflect.TypeFor[E]()}, errorHandler)
+}
+
+// errorChain returns every error in the tree of err, in the same depth-first order errors.Is uses.
+func errorChain(err error) []error {
+	var chain []error
+
+	walkErrors(err, maxUnwrapDepth, func(err error) bool {
+		chain = append(chain, err)
+
+		return false
+	})
+
+	return chain
+}
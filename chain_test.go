@@ -0,0 +1,83 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterErrorChainHandlerOn_PassesUnwrapChain(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var chain []error
+
+	RegisterErrorChainHandlerOn(registry, func(_ context.Context, err *AError, errs []error) (int, any) {
+		chain = errs
+
+		return http.StatusBadRequest, err.message
+	})
+
+	aErr := &AError{message: "invalid"}
+	wrapped := fmt.Errorf("validating order: %w", aErr)
+	err := fmt.Errorf("creating order: %w", wrapped)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, err)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "invalid", response)
+	assert.Equal(t, []error{err, wrapped, aErr}, chain)
+}
+
+func TestRegisterErrorChainHandlerOn_FlattensJoinedErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var chain []error
+
+	RegisterErrorChainHandlerOn(registry, func(_ context.Context, _ *AError, errs []error) (int, any) {
+		chain = errs
+
+		return http.StatusBadRequest, nil
+	})
+
+	aErr := &AError{message: "invalid"}
+	other := errors.New("other")
+	err := errors.Join(other, aErr)
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, err)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, []error{err, other, aErr}, chain)
+}
+
+func TestRegisterErrorChainHandlerOn_SkipsWithoutHandlerForVersion(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorChainHandlerOn(registry, func(context.Context, *AError, []error) (int, any) {
+		return http.StatusTeapot, "v2"
+	}, ForAPIVersion("v2"))
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	v1Code, _ := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v1"), registry, &AError{})
+	v2Code, v2Response := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v2"), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, http.StatusInternalServerError, v1Code)
+	assert.Equal(t, http.StatusTeapot, v2Code)
+	assert.Equal(t, "v2", v2Response)
+}
@@ -0,0 +1,49 @@
+package ginerr
+
+import (
+	"maps"
+	"slices"
+)
+
+// Clone returns an independent copy of the registry with the same registrations and settings, so request- or
+// tenant-specific registries can be derived from a shared base without modifying it. Registering handlers on the
+// clone or changing its settings doesn't affect the registry and vice versa. The handlers and callbacks themselves,
+// and the parent of the registry, are shared.
+func (e *ErrorRegistry) Clone() *ErrorRegistry {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	clone := &ErrorRegistry{
+		handlers:             maps.Clone(e.handlers),
+		order:                slices.Clone(e.order),
+		matcherOrder:         slices.Clone(e.matcherOrder),
+		joinStrategy:         e.joinStrategy,
+		defaultHandler:       e.defaultHandler,
+		remoteErrors:         maps.Clone(e.remoteErrors),
+		differenceObserver:   e.differenceObserver,
+		differenceSampleRate: e.differenceSampleRate,
+		policies:             slices.Clone(e.policies),
+		legacyResolver:       e.legacyResolver,
+		stringErrors:         e.stringErrors,
+		softLimits:           e.softLimits,
+		softLimitWarning:     e.softLimitWarning,
+		requestIDSource:      e.requestIDSource,
+		jsonEncoder:          e.jsonEncoder,
+		matchers:             e.matchers,
+		matchPreference:      e.matchPreference,
+		unwrapDepth:          e.unwrapDepth,
+		nilHandler:           e.nilHandler,
+		aggregateHandler:     e.aggregateHandler,
+		statusCodeBody:       e.statusCodeBody,
+		strict:               e.strict,
+		fingerprintHeader:    e.fingerprintHeader,
+		conflictPolicy:       e.conflictPolicy,
+		conflicts:            slices.Clone(e.conflicts),
+		parent:               e.parent,
+	}
+
+	// The evaluation might share the order, so it's rebuilt instead of copied
+	clone.updateEvaluation()
+
+	return clone
+}
@@ -0,0 +1,76 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorRegistry_Clone_CopiesRegistrationsAndSettings(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.RegisterDefaultHandler(func(context.Context, error) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	errNotFound := errors.New("not found")
+
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	clone := registry.Clone()
+
+	// Assert
+	notFoundCode, _ := NewErrorResponseFrom(context.Background(), clone, errNotFound)
+	defaultCode, _ := NewErrorResponseFrom(context.Background(), clone, &AError{})
+
+	assert.Equal(t, http.StatusNotFound, notFoundCode)
+	assert.Equal(t, http.StatusServiceUnavailable, defaultCode)
+	assert.Equal(t, registry.Stats(), clone.Stats())
+	assert.Equal(t, registry.Rules(), clone.Rules())
+}
+
+func TestErrorRegistry_Clone_IsIndependent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	clone := registry.Clone()
+
+	// Act
+	RegisterErrorHandlerOn(clone, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusGone, nil
+	})
+	RegisterTypeOn(clone, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+		return http.StatusConflict, nil
+	})
+
+	// Assert
+	registryCode, _ := NewErrorResponseFrom(context.Background(), registry, errNotFound)
+	cloneCode, _ := NewErrorResponseFrom(context.Background(), clone, errNotFound)
+	registryTypeCode, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	cloneTypeCode, _ := NewErrorResponseFrom(context.Background(), clone, &BError{})
+
+	assert.Equal(t, http.StatusNotFound, registryCode)
+	assert.Equal(t, http.StatusGone, cloneCode)
+	assert.Equal(t, http.StatusInternalServerError, registryTypeCode)
+	assert.Equal(t, http.StatusInternalServerError, cloneTypeCode)
+	assert.Len(t, registry.Rules(), 2)
+	assert.Len(t, clone.Rules(), 2)
+}
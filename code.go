@@ -0,0 +1,34 @@
+package ginerr
+
+import (
+	"context"
+	"strconv"
+)
+
+// codeKey is the key of handlers registered for a machine-readable error code, see RegisterCodeHandlerOn
+type codeKey struct {
+	code string
+}
+
+func (c codeKey) Error() string {
+	return "code " + strconv.Quote(c.code)
+}
+
+// RegisterCodeHandler registers an error handler for errors with the given machine-readable code in
+// DefaultErrorRegistry.
+func RegisterCodeHandler(code string, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterCodeHandlerOn(DefaultErrorRegistry, code, handler, options...)
+}
+
+// RegisterCodeHandlerOn registers an error handler in the given registry for errors that implement `Code() string`
+// and return the given code, which is common in domain-error packages. The handler receives the error with the
+// code. Registering a handler for the same code again replaces it.
+func RegisterCodeHandlerOn(registry *ErrorRegistry, code string, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	matches := func(err error) bool {
+		errWithCode, ok := err.(codeError)
+
+		return ok && errWithCode.Code() == code
+	}
+
+	registry.addHandler(codeKey{code: code}, newMatcherHandler(matches, handler, options))
+}
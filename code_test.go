@@ -0,0 +1,79 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterCodeHandlerOn_MatchesErrorCode(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterCodeHandlerOn(registry, "ORDER_NOT_FOUND", func(_ context.Context, err error) (int, any) {
+		return http.StatusNotFound, fmt.Sprintf("%T", err)
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"code": {
+			err:              &codedError{code: "ORDER_NOT_FOUND"},
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: "*ginerr.codedError",
+		},
+		"wrapped code": {
+			err:              fmt.Errorf("loading order: %w", &codedError{code: "ORDER_NOT_FOUND"}),
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: "*ginerr.codedError",
+		},
+		"other code": {
+			err:          &codedError{code: "ORDER_CANCELLED"},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterCodeHandlerOn_ReplacesHandlerForSameCode(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterCodeHandlerOn(registry, "ORDER_NOT_FOUND", func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+	RegisterCodeHandlerOn(registry, "ORDER_NOT_FOUND", func(context.Context, error) (int, any) {
+		return http.StatusGone, nil
+	})
+
+	// Act
+	result := ResolveFrom(context.Background(), registry, &codedError{code: "ORDER_NOT_FOUND"})
+
+	// Assert
+	assert.Equal(t, http.StatusGone, result.Code)
+	assert.Equal(t, `code "ORDER_NOT_FOUND"`, result.HandlerName)
+
+	rules := registry.Rules()
+	require.Len(t, rules, 1)
+	assert.Equal(t, MatchPredicate, rules[0].Kind)
+	assert.NoError(t, rules[0].Error)
+}
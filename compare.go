@@ -0,0 +1,103 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Comparison is the difference between two registries, see CompareRegistries.
+type Comparison struct {
+	// Added are the registrations that are only in the new registry
+	Added []string
+
+	// Removed are the registrations that are only in the old registry
+	Removed []string
+
+	// Changed are the sample errors that resolve to a different response
+	Changed []ResponseChange
+}
+
+// ResponseChange describes how the response to a sample error changed between two registries.
+type ResponseChange struct {
+	// Err is the sample error that was resolved
+	Err error
+
+	// OldCode is the status code returned by the old registry
+	OldCode int
+
+	// NewCode is the status code returned by the new registry
+	NewCode int
+
+	// OldSchema is the Go type of the response of the old registry
+	OldSchema string
+
+	// NewSchema is the Go type of the response of the new registry
+	NewSchema string
+}
+
+// CompareRegistries compares the registrations of two registries, and resolves the sample errors in both of them
+// to find changes in status codes and response types. Handlers are functions, so responses can only be compared
+// for the given samples. The result can be printed for release notes or API change reviews.
+func CompareRegistries(ctx context.Context, before *ErrorRegistry, after *ErrorRegistry, samples ...error) Comparison {
+	oldRegistrations := before.registrations()
+	newRegistrations := after.registrations()
+
+	var comparison Comparison
+
+	for _, registration := range newRegistrations {
+		if _, found := slices.BinarySearch(oldRegistrations, registration); !found {
+			comparison.Added = append(comparison.Added, registration)
+		}
+	}
+
+	for _, registration := range oldRegistrations {
+		if _, found := slices.BinarySearch(newRegistrations, registration); !found {
+			comparison.Removed = append(comparison.Removed, registration)
+		}
+	}
+
+	for _, sample := range samples {
+		oldCode, oldResponse := NewErrorResponseFrom(ctx, before, sample)
+		newCode, newResponse := NewErrorResponseFrom(ctx, after, sample)
+
+		change := ResponseChange{
+			Err:       sample,
+			OldCode:   oldCode,
+			NewCode:   newCode,
+			OldSchema: fmt.Sprintf("%T", oldResponse),
+			NewSchema: fmt.Sprintf("%T", newResponse),
+		}
+
+		if change.OldCode != change.NewCode || change.OldSchema != change.NewSchema {
+			comparison.Changed = append(comparison.Changed, change)
+		}
+	}
+
+	return comparison
+}
+
+// IsEmpty returns true if the registries have the same registrations and responses.
+func (c Comparison) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0 && len(c.Changed) == 0
+}
+
+// String returns the comparison as a diff, with a line per added (+), removed (-) and changed (~) entry.
+func (c Comparison) String() string {
+	var builder strings.Builder
+
+	for _, registration := range c.Added {
+		fmt.Fprintf(&builder, "+ %s\n", registration)
+	}
+
+	for _, registration := range c.Removed {
+		fmt.Fprintf(&builder, "- %s\n", registration)
+	}
+
+	for _, change := range c.Changed {
+		fmt.Fprintf(&builder, "~ %q: %d %s -> %d %s\n", change.Err.Error(), change.OldCode, change.OldSchema, change.NewCode, change.NewSchema)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,93 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareRegistries_ReturnsDifferences(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	errNotFound := errors.New("not found")
+	errGone := errors.New("gone")
+
+	before := NewErrorRegistry()
+	RegisterErrorHandlerOn(before, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, "not found"
+	})
+	RegisterErrorHandlerOn(before, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "a"
+	})
+
+	after := NewErrorRegistry()
+	RegisterErrorHandlerOn(after, errGone, func(context.Context, error) (int, any) {
+		return http.StatusGone, "gone"
+	})
+	RegisterErrorHandlerOn(after, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, "a"
+	})
+
+	// Act
+	result := CompareRegistries(context.Background(), before, after, errNotFound, errGone, &AError{message: "a"}, &BError{})
+
+	// Assert
+	expected := Comparison{
+		Added:   []string{`handler error "gone"`},
+		Removed: []string{`handler error "not found"`},
+		Changed: []ResponseChange{
+			{Err: errNotFound, OldCode: http.StatusNotFound, NewCode: http.StatusInternalServerError, OldSchema: "string", NewSchema: "<nil>"},
+			{Err: errGone, OldCode: http.StatusInternalServerError, NewCode: http.StatusGone, OldSchema: "<nil>", NewSchema: "string"},
+			{Err: &AError{message: "a"}, OldCode: http.StatusBadRequest, NewCode: http.StatusConflict, OldSchema: "string", NewSchema: "string"},
+		},
+	}
+
+	assert.Equal(t, expected, result)
+	assert.False(t, result.IsEmpty())
+}
+
+func TestCompareRegistries_ReturnsEmptyOnEqualRegistries(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	before := NewErrorRegistry()
+	RegisterErrorHandlerOn(before, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "a"
+	})
+
+	after := NewErrorRegistry()
+	RegisterErrorHandlerOn(after, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "other text, same schema"
+	})
+
+	// Act
+	result := CompareRegistries(context.Background(), before, after, &AError{})
+
+	// Assert
+	assert.True(t, result.IsEmpty())
+	assert.Empty(t, result.String())
+}
+
+func TestComparison_String_ReturnsDiff(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	comparison := Comparison{
+		Added:   []string{`handler error "gone"`},
+		Removed: []string{"remote NOT_FOUND not found"},
+		Changed: []ResponseChange{
+			{Err: &AError{message: "a"}, OldCode: http.StatusBadRequest, NewCode: http.StatusConflict, OldSchema: "string", NewSchema: "*respond.Error"},
+		},
+	}
+
+	// Act
+	result := comparison.String()
+
+	// Assert
+	expected := "+ handler error \"gone\"\n" +
+		"- remote NOT_FOUND not found\n" +
+		"~ \"a\": 400 string -> 409 *respond.Error\n"
+
+	assert.Equal(t, expected, result)
+}
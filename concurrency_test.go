@@ -0,0 +1,144 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// These tests are meant to be run with -race, they exercise registration and resolution at the same time
+
+func TestErrorRegistry_SupportsConcurrentRegistrationAndResolution(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.RegisterDifferenceObserver(1, func(context.Context, Difference) {})
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "a"
+	})
+
+	var wg sync.WaitGroup
+
+	// Act
+	for i := range 50 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			RegisterErrorHandlerOn(registry, fmt.Errorf("error %d", i), func(context.Context, error) (int, any) {
+				return http.StatusConflict, nil
+			})
+			RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+				return http.StatusNotFound, nil
+			})
+			RegisterRemoteErrorOn(registry, fmt.Sprint(i), assert.AnError)
+			registry.RegisterPolicy(ForbidStatuses(200, 299))
+			registry.RegisterDefaultHandler(func(context.Context, error) (int, any) {
+				return http.StatusInternalServerError, nil
+			})
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			code, _ := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", &AError{}))
+			assert.Equal(t, http.StatusBadRequest, code)
+
+			_, _ = NewErrorResponseFrom(context.Background(), registry, assert.AnError)
+			_ = HydrateRemoteErrorFrom(registry, &RemoteError{Code: fmt.Sprint(i)})
+			_ = registry.Validate()
+
+			for range registry.All() {
+				continue
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Assert
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &BError{})
+	assert.Equal(t, http.StatusNotFound, code)
+}
+
+func TestErrorRegistry_AllowsRegistrationFromHandlers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		// Would deadlock if the lock was held while calling handlers
+		RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+			return http.StatusNotFound, nil
+		})
+
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func BenchmarkNewErrorResponseFrom_Parallel(b *testing.B) {
+	registry := NewErrorRegistry()
+
+	for i := range 10 {
+		RegisterErrorHandlerOn(registry, fmt.Errorf("error %d", i), func(context.Context, error) (int, any) {
+			return http.StatusConflict, nil
+		})
+	}
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	err := fmt.Errorf("wrapped: %w", &AError{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = NewErrorResponseFrom(context.Background(), registry, err)
+		}
+	})
+}
+
+func BenchmarkNewErrorResponseFrom_ParallelWithRegistration(b *testing.B) {
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	sentinel := errors.New("sentinel")
+	err := fmt.Errorf("wrapped: %w", &AError{})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			// Register every now and then, re-using the same key to keep the registry small
+			if i%100 == 0 {
+				RegisterErrorHandlerOn(registry, sentinel, func(context.Context, error) (int, any) {
+					return http.StatusConflict, nil
+				})
+			}
+
+			_, _ = NewErrorResponseFrom(context.Background(), registry, err)
+			i++
+		}
+	})
+}
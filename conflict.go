@@ -0,0 +1,76 @@
+package ginerr
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// ConflictPolicy decides what happens if a registration conflicts with an earlier one, see SetConflictPolicy.
+type ConflictPolicy int
+
+const (
+	// ConflictOverwrite silently replaces the earlier registration for the same error, it's the default.
+	ConflictOverwrite ConflictPolicy = iota
+
+	// ConflictLog logs conflicting registrations as a warning, they are still applied like ConflictOverwrite.
+	ConflictLog
+
+	// ConflictPanic panics on conflicting registrations, it's meant to fail fast at startup.
+	ConflictPanic
+
+	// ConflictReject ignores conflicting registrations and reports them through Validate instead.
+	ConflictReject
+)
+
+// SetConflictPolicy sets what happens if a registration conflicts with an earlier one. A registration conflicts if
+// there already is a registration for the same error, type or API version, if another error of the same type was
+// registered, or if it's registered for the error interface itself, which shadows all other registrations.
+// Registrations that are only reached if others decline the error, like matchers and string errors of the same
+// type, never conflict.
+func (e *ErrorRegistry) SetConflictPolicy(policy ConflictPolicy) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.conflictPolicy = policy
+}
+
+// conflict returns an error describing how handler conflicts with the registrations in the registry if it's
+// registered under key, or nil if it doesn't. The caller must hold the lock.
+func (e *ErrorRegistry) conflict(key error, handler *errorHandler) error {
+	previous, exists := e.handlers[key]
+
+	switch {
+	case exists && handler.apiVersion != "":
+		if _, ok := previous.variants[handler.apiVersion]; ok {
+			return fmt.Errorf("%w: %v for API version %q was already registered", ErrDuplicateHandler,
+				describeRegistration(key, handler), handler.apiVersion)
+		}
+
+		return nil
+	case exists && !previous.placeholder:
+		return fmt.Errorf("%w: %v was already registered", ErrDuplicateHandler, describeRegistration(key, handler))
+	case handler.isStringError || handler.isMatcher:
+		return nil
+	case handler.errorType == errorInterfaceType:
+		return fmt.Errorf("%w: %v", ErrShadowingHandler, describeRegistration(key, handler))
+	}
+
+	for otherKey, other := range e.handlers {
+		if otherKey != key && !other.isStringError && !other.isMatcher && other.errorType == handler.errorType {
+			return fmt.Errorf("%w: %v was already registered", ErrDuplicateHandler, describeRegistration(key, handler))
+		}
+	}
+
+	return nil
+}
+
+// reportConflict logs or panics on the conflict according to the policy, it's called without holding the lock.
+func reportConflict(policy ConflictPolicy, conflict error) {
+	switch policy {
+	case ConflictLog:
+		slog.Warn("ginerr: conflicting registration", slog.Any("error", conflict))
+	case ConflictPanic:
+		panic("ginerr: " + conflict.Error())
+	case ConflictOverwrite, ConflictReject:
+	}
+}
@@ -0,0 +1,175 @@
+package ginerr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorRegistry_SetConflictPolicy_DetectsConflicts(t *testing.T) {
+	t.Parallel()
+	errNotFound := errors.New("not found")
+	aErr := &AError{}
+
+	handler := func(context.Context, error) (int, any) {
+		return http.StatusBadRequest, nil
+	}
+
+	tests := map[string]struct {
+		register      func(registry *ErrorRegistry)
+		expectedError error
+	}{
+		"same sentinel": {
+			register: func(registry *ErrorRegistry) {
+				RegisterErrorHandlerOn(registry, errNotFound, handler)
+				RegisterErrorHandlerOn(registry, errNotFound, handler)
+			},
+			expectedError: ErrDuplicateHandler,
+		},
+		"same type": {
+			register: func(registry *ErrorRegistry) {
+				RegisterTypeOn(registry, func(context.Context, *AError) (int, any) { return http.StatusBadRequest, nil })
+				RegisterErrorHandlerOn(registry, aErr, func(context.Context, *AError) (int, any) { return http.StatusBadRequest, nil })
+			},
+			expectedError: ErrDuplicateHandler,
+		},
+		"same API version": {
+			register: func(registry *ErrorRegistry) {
+				RegisterErrorHandlerOn(registry, errNotFound, handler, ForAPIVersion("v2"))
+				RegisterErrorHandlerOn(registry, errNotFound, handler, ForAPIVersion("v2"))
+			},
+			expectedError: ErrDuplicateHandler,
+		},
+		"error interface": {
+			register: func(registry *ErrorRegistry) {
+				RegisterTypeOn(registry, handler)
+			},
+			expectedError: ErrShadowingHandler,
+		},
+		"different sentinels": {
+			register: func(registry *ErrorRegistry) {
+				RegisterErrorHandlerOn(registry, errNotFound, handler)
+				RegisterErrorHandlerOn(registry, errors.New("conflict"), handler)
+			},
+		},
+		"API version variants": {
+			register: func(registry *ErrorRegistry) {
+				RegisterErrorHandlerOn(registry, errNotFound, handler, ForAPIVersion("v2"))
+				RegisterErrorHandlerOn(registry, errNotFound, handler)
+				RegisterErrorHandlerOn(registry, errNotFound, handler, ForAPIVersion("v3"))
+			},
+		},
+		"matchers": {
+			register: func(registry *ErrorRegistry) {
+				RegisterMatcherHandlerOn(registry, func(error) bool { return true }, handler)
+				RegisterMatcherHandlerOn(registry, func(error) bool { return true }, handler)
+			},
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+			registry.SetConflictPolicy(ConflictReject)
+
+			// Act
+			testData.register(registry)
+
+			// Assert
+			err := registry.Validate()
+
+			if testData.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testData.expectedError)
+			}
+		})
+	}
+}
+
+func TestErrorRegistry_SetConflictPolicy_RejectKeepsEarlierRegistration(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetConflictPolicy(ConflictReject)
+
+	errNotFound := errors.New("not found")
+
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusGone, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, errNotFound)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.EqualError(t, registry.Validate(),
+		`multiple handlers registered for the same type: error "not found" was already registered`)
+}
+
+func TestErrorRegistry_SetConflictPolicy_PanicsOnConflict(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetConflictPolicy(ConflictPanic)
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	result := func() {
+		RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+			return http.StatusConflict, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: multiple handlers registered for the same type: type *ginerr.AError was already registered", result)
+}
+
+//nolint:paralleltest // Can't be used, we change the default logger
+func TestErrorRegistry_SetConflictPolicy_LogsAndOverwritesConflict(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buffer, nil)))
+
+	defer slog.SetDefault(defaultLogger)
+
+	registry := NewErrorRegistry()
+	registry.SetConflictPolicy(ConflictLog)
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, nil
+	})
+
+	// Assert
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	assert.Equal(t, http.StatusConflict, code)
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 1)
+
+	assert.Equal(t, "WARN", lines[0]["level"])
+	assert.Equal(t, "ginerr: conflicting registration", lines[0]["msg"])
+	assert.Equal(t, "multiple handlers registered for the same type: type *ginerr.AError was already registered", lines[0]["error"])
+}
@@ -0,0 +1,57 @@
+package ginerr
+
+import (
+	"context"
+	"math/rand/v2"
+)
+
+// Difference contains the response of a matched handler and the response the default handler would have
+// returned for the same error, it's used to assess the impact of removing or changing a handler.
+type Difference struct {
+	// Err is the error that was resolved
+	Err error
+
+	// MatchedCode is the status code returned by the matched handler
+	MatchedCode int
+
+	// MatchedResponse is the response returned by the matched handler
+	MatchedResponse any
+
+	// DefaultCode is the status code the default handler would have returned
+	DefaultCode int
+
+	// DefaultResponse is the response the default handler would have returned
+	DefaultResponse any
+}
+
+// RegisterDifferenceObserver registers an observer that is called for the given fraction (0 to 1) of matched
+// errors with both the matched response and the response of the default handler, for example to log them.
+// Keep in mind that the default handler is called an extra time for sampled errors.
+func (e *ErrorRegistry) RegisterDifferenceObserver(sampleRate float64, observer func(ctx context.Context, difference Difference)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.differenceSampleRate = sampleRate
+	e.differenceObserver = observer
+}
+
+// observeDifference calls the difference observer if one was registered and the error was sampled.
+func (e *ErrorRegistry) observeDifference(ctx context.Context, err error, code int, response any) {
+	e.mu.RLock()
+	observer, sampleRate := e.differenceObserver, e.differenceSampleRate
+	e.mu.RUnlock()
+
+	if observer == nil || rand.Float64() >= sampleRate { //nolint:gosec // No need for a secure random here
+		return
+	}
+
+	defaultCode, defaultResponse := e.callDefaultHandler(ctx, err)
+
+	observer(ctx, Difference{
+		Err:             err,
+		MatchedCode:     code,
+		MatchedResponse: response,
+		DefaultCode:     defaultCode,
+		DefaultResponse: defaultResponse,
+	})
+}
@@ -0,0 +1,84 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterDifferenceObserver_CallsObserverWithBothResponses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "bad"
+	})
+
+	var calledWithCtx context.Context
+	var calledWithDifference Difference
+	registry.RegisterDifferenceObserver(1, func(ctx context.Context, difference Difference) {
+		calledWithCtx = ctx
+		calledWithDifference = difference
+	})
+
+	ctx := context.WithValue(context.Background(), dummyContextKey("abc"), "good")
+	err := &AError{message: "abc"}
+
+	// Act
+	code, response := NewErrorResponseFrom(ctx, registry, err)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "bad", response)
+
+	assert.Equal(t, ctx, calledWithCtx)
+	expected := Difference{
+		Err:             err,
+		MatchedCode:     http.StatusBadRequest,
+		MatchedResponse: "bad",
+		DefaultCode:     http.StatusInternalServerError,
+		DefaultResponse: nil,
+	}
+	assert.Equal(t, expected, calledWithDifference)
+}
+
+func TestRegisterDifferenceObserver_DoesNotCallObserverOnZeroSampleRate(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "bad"
+	})
+
+	var called bool
+	registry.RegisterDifferenceObserver(0, func(context.Context, Difference) {
+		called = true
+	})
+
+	// Act
+	_, _ = NewErrorResponseFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.False(t, called)
+}
+
+func TestRegisterDifferenceObserver_DoesNotCallObserverOnDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var called bool
+	registry.RegisterDifferenceObserver(1, func(context.Context, Difference) {
+		called = true
+	})
+
+	// Act
+	_, _ = NewErrorResponseFrom(context.Background(), registry, assert.AnError)
+
+	// Assert
+	assert.False(t, called)
+}
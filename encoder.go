@@ -0,0 +1,37 @@
+package ginerr
+
+import "encoding/json"
+
+// JSONEncoder marshals response bodies to JSON. The API of jsoniter, like jsoniter.ConfigCompatibleWithStandardLibrary,
+// implements it, and functions like the Marshal of segmentio/encoding/json can be used with JSONEncoderFunc.
+type JSONEncoder interface {
+	Marshal(v any) ([]byte, error)
+}
+
+// JSONEncoderFunc allows ordinary functions to be used as a JSONEncoder.
+type JSONEncoderFunc func(v any) ([]byte, error)
+
+func (f JSONEncoderFunc) Marshal(v any) ([]byte, error) {
+	return f(v)
+}
+
+// SetJSONEncoder sets the encoder that is used to write JSON responses by AbortWithErrorFrom, WrapHandlerFrom,
+// Middleware and WriteErrorFrom, for services that standardize on a faster encoder. Nil restores the default,
+// which is gin's encoder for gin requests and encoding/json otherwise.
+func (e *ErrorRegistry) SetJSONEncoder(encoder JSONEncoder) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.jsonEncoder = encoder
+}
+
+// getJSONEncoder returns the encoder set by SetJSONEncoder, or nil if none was set.
+func (e *ErrorRegistry) getJSONEncoder() JSONEncoder {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.jsonEncoder
+}
+
+// defaultJSONEncoder is used if no encoder was set outside of gin requests
+var defaultJSONEncoder = JSONEncoderFunc(json.Marshal)
@@ -0,0 +1,122 @@
+package ginerr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ing-bank/ginerr/v3/respond"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upperEncoder is a JSONEncoder that is easy to recognise in responses
+var upperEncoder = JSONEncoderFunc(func(any) ([]byte, error) {
+	return []byte(`"ENCODED"`), nil
+})
+
+func TestErrorRegistry_SetJSONEncoder_IsUsedByAbortWithErrorFrom(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetJSONEncoder(upperEncoder)
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return respond.BadRequest("bad request")
+	})
+
+	engine := newTestEngine(func(c *gin.Context) {
+		AbortWithErrorFrom(c, registry, &AError{})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, `"ENCODED"`, recorder.Body.String())
+}
+
+func TestErrorRegistry_SetJSONEncoder_IsUsedByWriteErrorFrom(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetJSONEncoder(upperEncoder)
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return respond.BadRequest("bad request")
+	})
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	err := WriteErrorFrom(recorder, httptest.NewRequest(http.MethodGet, "/", http.NoBody), registry, &AError{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `"ENCODED"`, recorder.Body.String())
+}
+
+func TestErrorRegistry_SetJSONEncoder_WritesInternalServerErrorOnEncodingError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetJSONEncoder(JSONEncoderFunc(func(any) ([]byte, error) {
+		return nil, assert.AnError
+	}))
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return respond.BadRequest("bad request")
+	})
+
+	var ginErrors []*gin.Error
+
+	engine := newTestEngine(func(c *gin.Context) {
+		AbortWithErrorFrom(c, registry, &AError{})
+
+		ginErrors = c.Errors
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+
+	require.Len(t, ginErrors, 1)
+	assert.ErrorIs(t, ginErrors[0], assert.AnError)
+}
+
+func BenchmarkAbortWithErrorFrom_Encoders(b *testing.B) {
+	encoders := map[string]JSONEncoder{
+		"gin":           nil,
+		"encoding/json": JSONEncoderFunc(json.Marshal),
+	}
+
+	for name, encoder := range encoders {
+		b.Run(name, func(b *testing.B) {
+			registry := NewErrorRegistry()
+			registry.SetJSONEncoder(encoder)
+
+			RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+				return respond.FieldErrors(respond.Field{Name: "amount", Message: "must be positive"})
+			})
+
+			engine := newTestEngine(func(c *gin.Context) {
+				AbortWithErrorFrom(c, registry, &AError{})
+			})
+
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for range b.N {
+				serveTestRequest(engine)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package ginerr
+
+import (
+	"context"
+	"strconv"
+)
+
+// equalityKey is the key of handlers registered with an equality function, the registered error might not be
+// usable as a map key
+type equalityKey struct {
+	id int
+}
+
+func (e equalityKey) Error() string {
+	return "equality matcher " + strconv.Itoa(e.id)
+}
+
+// RegisterEqualityHandler registers an error handler for errors equal to the registered error according to equal in
+// DefaultErrorRegistry.
+func RegisterEqualityHandler(registered error, equal func(registered error, got error) bool, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterEqualityHandlerOn(DefaultErrorRegistry, registered, equal, handler, options...)
+}
+
+// RegisterEqualityHandlerOn registers an error handler in the given registry for errors that are equal to the
+// registered error according to equal, for sentinel-like errors that aren't comparable, like a struct with a slice
+// field, so they don't have to be matched by their type alone. Equal is called for every error in the tree and the
+// handler receives the first error it returns true for. It panics if the registered error or equal is nil.
+func RegisterEqualityHandlerOn(registry *ErrorRegistry, registered error, equal func(registered error, got error) bool, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	if registered == nil || equal == nil {
+		panic("ginerr: can't register an equality handler for a nil error or equality function")
+	}
+
+	registry.mu.Lock()
+	registry.matchers++
+	key := equalityKey{id: registry.matchers}
+	registry.mu.Unlock()
+
+	errorHandler := newMatcherHandler(func(err error) bool {
+		return equal(registered, err)
+	}, handler, options)
+	errorHandler.instance = registered
+
+	registry.addHandler(key, errorHandler)
+}
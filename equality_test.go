@@ -0,0 +1,108 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fieldsError isn't comparable, so it can't be registered as a sentinel
+type fieldsError struct {
+	fields []string
+}
+
+func (e fieldsError) Error() string {
+	return fmt.Sprintf("invalid fields %v", e.fields)
+}
+
+func equalFields(registered error, got error) bool {
+	registeredErr, _ := registered.(fieldsError)
+	gotErr, ok := got.(fieldsError)
+
+	return ok && slices.Equal(registeredErr.fields, gotErr.fields)
+}
+
+func TestRegisterEqualityHandlerOn_MatchesEqualErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterEqualityHandlerOn(registry, fieldsError{fields: []string{"iban"}}, equalFields, func(_ context.Context, err error) (int, any) {
+		return http.StatusBadRequest, err.Error()
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"equal": {
+			err:              fieldsError{fields: []string{"iban"}},
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "invalid fields [iban]",
+		},
+		"wrapped equal": {
+			err:              fmt.Errorf("transfer: %w", fieldsError{fields: []string{"iban"}}),
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "invalid fields [iban]",
+		},
+		"not equal": {
+			err:          fieldsError{fields: []string{"amount"}},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterEqualityHandlerOn_IsDescribedByRegisteredError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	registered := fieldsError{fields: []string{"iban"}}
+
+	RegisterEqualityHandlerOn(registry, registered, equalFields, func(context.Context, error) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	rules := registry.Rules()
+
+	// Assert
+	require.Len(t, rules, 1)
+	assert.Equal(t, registered, rules[0].Error)
+	assert.Equal(t, `error "invalid fields [iban]"`, ResolveFrom(context.Background(), registry, registered).HandlerName)
+	assert.NoError(t, registry.Validate())
+}
+
+func TestRegisterEqualityHandlerOn_PanicsOnNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterEqualityHandlerOn(registry, fieldsError{}, nil, func(context.Context, error) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register an equality handler for a nil error or equality function", result)
+}
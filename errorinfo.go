@@ -0,0 +1,65 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+// Headers set by InjectErrorInfo.
+const (
+	ErrorCodeHeader   = "X-Ginerr-Error-Code"
+	ErrorStatusHeader = "X-Ginerr-Error-Status"
+)
+
+// ErrorInfo describes a resolved error, it can be propagated to downstream calls and async tasks
+// so they can be correlated with the originating failure.
+type ErrorInfo struct {
+	// Status is the status code the error was resolved to
+	Status int
+
+	// Code is the machine-readable code of the error, taken from errors that implement `Code() string`
+	Code string
+}
+
+// NewErrorInfo creates an ErrorInfo from a resolved status code and the error it was resolved from.
+func NewErrorInfo(status int, err error) ErrorInfo {
+	info := ErrorInfo{Status: status}
+
+	var errWithCode codeError
+	if errorsAs(err, &errWithCode) {
+		info.Code = errWithCode.Code()
+	}
+
+	return info
+}
+
+// errorInfoContextKey is the context key under which the error info is stored
+type errorInfoContextKey struct{}
+
+// WithErrorInfo returns a copy of the context annotated with the error info.
+func WithErrorInfo(ctx context.Context, info ErrorInfo) context.Context {
+	return context.WithValue(ctx, errorInfoContextKey{}, info)
+}
+
+// ErrorInfoFromContext returns the error info set by WithErrorInfo, the boolean is false if none was set.
+func ErrorInfoFromContext(ctx context.Context) (ErrorInfo, bool) {
+	info, ok := ctx.Value(errorInfoContextKey{}).(ErrorInfo)
+
+	return info, ok
+}
+
+// InjectErrorInfo sets the error info of the context on the headers of an outgoing request, it does
+// nothing if the context has no error info.
+func InjectErrorInfo(ctx context.Context, header http.Header) {
+	info, ok := ErrorInfoFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	header.Set(ErrorStatusHeader, strconv.Itoa(info.Status))
+
+	if info.Code != "" {
+		header.Set(ErrorCodeHeader, info.Code)
+	}
+}
@@ -0,0 +1,79 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewErrorInfo_ReturnsCodeOfError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	err := fmt.Errorf("wrapped: %w", &codedError{code: "ORDER_NOT_FOUND"})
+
+	// Act
+	result := NewErrorInfo(http.StatusNotFound, err)
+
+	// Assert
+	assert.Equal(t, ErrorInfo{Status: http.StatusNotFound, Code: "ORDER_NOT_FOUND"}, result)
+}
+
+func TestNewErrorInfo_ReturnsNoCodeOnErrorWithoutCode(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := NewErrorInfo(http.StatusInternalServerError, assert.AnError)
+
+	// Assert
+	assert.Equal(t, ErrorInfo{Status: http.StatusInternalServerError}, result)
+}
+
+func TestErrorInfoFromContext_ReturnsErrorInfo(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := WithErrorInfo(context.Background(), ErrorInfo{Status: http.StatusConflict, Code: "CONFLICT"})
+
+	// Act
+	result, ok := ErrorInfoFromContext(ctx)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, ErrorInfo{Status: http.StatusConflict, Code: "CONFLICT"}, result)
+}
+
+func TestErrorInfoFromContext_ReturnsFalseOnNoErrorInfo(t *testing.T) {
+	t.Parallel()
+	// Act
+	_, ok := ErrorInfoFromContext(context.Background())
+
+	// Assert
+	assert.False(t, ok)
+}
+
+func TestInjectErrorInfo_SetsHeaders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := WithErrorInfo(context.Background(), ErrorInfo{Status: http.StatusConflict, Code: "CONFLICT"})
+	header := http.Header{}
+
+	// Act
+	InjectErrorInfo(ctx, header)
+
+	// Assert
+	assert.Equal(t, "409", header.Get(ErrorStatusHeader))
+	assert.Equal(t, "CONFLICT", header.Get(ErrorCodeHeader))
+}
+
+func TestInjectErrorInfo_DoesNothingOnNoErrorInfo(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	header := http.Header{}
+
+	// Act
+	InjectErrorInfo(context.Background(), header)
+
+	// Assert
+	assert.Empty(t, header)
+}
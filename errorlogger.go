@@ -0,0 +1,67 @@
+package ginerr
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ErrorLogger is a replacement for gin.ErrorLogger, see ErrorLoggerT.
+func ErrorLogger(logger *slog.Logger) gin.HandlerFunc {
+	return ErrorLoggerT(logger, gin.ErrorTypeAny)
+}
+
+// ErrorLoggerT is a replacement for gin.ErrorLoggerT that uses the registry attached to the request (see WithRegistry)
+// or the DefaultErrorRegistry. Instead of writing the errors of the given type as a raw JSON dump, the last one is
+// resolved using the registry, and all of them are logged with the response status. The resolved one is also logged
+// with the HandlerName of its ErrorResponse. Nothing is written if the response was already written by the handler.
+func ErrorLoggerT(logger *slog.Logger, errorType gin.ErrorType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		logErrors(c, registryFromContext(c), logger, errorType)
+	}
+}
+
+// ErrorLoggerFrom returns a gin middleware like ErrorLoggerT, but using the given registry.
+func ErrorLoggerFrom(registry *ErrorRegistry, logger *slog.Logger, errorType gin.ErrorType) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		logErrors(c, registry, logger, errorType)
+	}
+}
+
+// logErrors resolves the last gin error of the given type if nothing was written yet, and logs all of them.
+func logErrors(c *gin.Context, registry *ErrorRegistry, logger *slog.Logger, errorType gin.ErrorType) {
+	ginErrors := c.Errors.ByType(errorType)
+	if len(ginErrors) == 0 {
+		return
+	}
+
+	resolved := ginErrors.Last()
+
+	var response ErrorResponse
+	if c.Writer.Written() {
+		resolved = nil
+	} else {
+		response = abortWithErrorFrom(c, registry, resolved)
+	}
+
+	for _, ginErr := range ginErrors {
+		attrs := []slog.Attr{slog.String("error", ginErr.Error())}
+
+		// Only the resolved error has a handler, the others were never used for the response
+		if ginErr == resolved {
+			attrs = append(attrs, slog.String("handler", response.HandlerName))
+		}
+
+		attrs = append(attrs,
+			slog.Int("status", c.Writer.Status()),
+			slog.String("method", c.Request.Method),
+			slog.String("route", c.FullPath()),
+		)
+
+		logger.LogAttrs(c, slog.LevelError, "request error", attrs...)
+	}
+}
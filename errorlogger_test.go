@@ -0,0 +1,146 @@
+package ginerr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeLogLines decodes the lines written by a slog.JSONHandler
+func decodeLogLines(t *testing.T, buffer *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var result []map[string]any
+
+	decoder := json.NewDecoder(buffer)
+	for decoder.More() {
+		var line map[string]any
+		require.NoError(t, decoder.Decode(&line))
+
+		result = append(result, line)
+	}
+
+	return result
+}
+
+func TestErrorLoggerFrom_RespondsAndLogsErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, "conflict"
+	})
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	engine := newTestEngine(ErrorLoggerFrom(registry, logger, gin.ErrorTypeAny), func(c *gin.Context) {
+		_ = c.Error(errors.New("unknown"))
+		_ = c.Error(&AError{message: "a"})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.JSONEq(t, `"conflict"`, recorder.Body.String())
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "unknown", lines[0]["error"])
+	assert.NotContains(t, lines[0], "handler")
+	assert.Equal(t, "a", lines[1]["error"])
+	assert.Equal(t, "type *ginerr.AError", lines[1]["handler"])
+
+	for _, line := range lines {
+		assert.Equal(t, "request error", line["msg"])
+		assert.InDelta(t, http.StatusConflict, line["status"], 0)
+		assert.Equal(t, http.MethodGet, line["method"])
+		assert.Equal(t, "/", line["route"])
+	}
+}
+
+func TestErrorLoggerFrom_LogsHandlerOfWrittenResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusOK, nil
+	})
+
+	registry.RegisterPolicy(ForbidStatuses(200, 399))
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	engine := newTestEngine(ErrorLoggerFrom(registry, logger, gin.ErrorTypeAny), func(c *gin.Context) {
+		_ = c.Error(&AError{message: "a"})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 1)
+
+	assert.Equal(t, "default", lines[0]["handler"])
+	assert.InDelta(t, http.StatusInternalServerError, lines[0]["status"], 0)
+}
+
+func TestErrorLoggerFrom_IgnoresOtherErrorTypes(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	engine := newTestEngine(ErrorLoggerFrom(NewErrorRegistry(), logger, gin.ErrorTypePublic), func(c *gin.Context) {
+		_ = c.Error(&AError{}).SetType(gin.ErrorTypePrivate)
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Empty(t, buffer.String())
+}
+
+func TestErrorLogger_DoesNotOverwriteWrittenResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	engine := newTestEngine(ErrorLogger(logger), func(c *gin.Context) {
+		_ = c.Error(&AError{message: "a"})
+
+		c.String(http.StatusTeapot, "teapot")
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusTeapot, recorder.Code)
+	assert.Equal(t, "teapot", recorder.Body.String())
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 1)
+	assert.InDelta(t, http.StatusTeapot, lines[0]["status"], 0)
+	assert.NotContains(t, lines[0], "handler")
+}
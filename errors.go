@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
 )
 
 // DefaultErrorRegistry is a global singleton empty ErrorRegistry for convenience.
@@ -16,20 +20,70 @@ type errorHandler struct {
 	// as we need to use errors.Is for those cases, errors.As is not enough
 	isStringError bool
 
-	// isType is a wrapped around an `errors.As` from RegisterERrorHandler with the type information
-	// of the target error still intact.
+	// isType checks if an error in the tree is of the registered type, like a single step of `errors.As`,
+	// with the type information of the target error still intact.
 	isType func(err error) bool
 
-	// handle will calculate the response. It's a wrapper around the user-provided handler
+	// handle will calculate the response and its headers. It's a wrapper around the user-provided handler
 	// which ensures that the type of the error is properly asserted using `errors.As`.
-	handle func(ctx context.Context, err error) (int, any)
+	handle func(ctx context.Context, err error) (int, any, http.Header)
+
+	// handleOriginal is used instead of handle if it's set, it also receives the error that is being resolved
+	handleOriginal func(ctx context.Context, err error, original error) (int, any, http.Header)
+
+	// errorType is the type of E the handler was registered with, used for validation
+	errorType reflect.Type
+
+	// isNil is true if the user-provided handler was nil, used for validation
+	isNil bool
+
+	// cacheMaxAge is how long clients may cache the response, see Cacheable
+	cacheMaxAge time.Duration
+
+	// isMatcher is true if the handler was registered with a predicate, see RegisterMatcherHandlerOn
+	isMatcher bool
+
+	// normalized is true if string errors are matched on their normalized message, see NormalizedMatch
+	normalized bool
+
+	// normalizedMessage is the normalized message of the string error, see NormalizedMatch
+	normalizedMessage string
+
+	// instance is the error the handler was registered with if it isn't the key, see RegisterEqualityHandlerOn
+	instance error
+
+	// apiVersion is the API version the handler is a variant for, see ForAPIVersion
+	apiVersion string
+
+	// variants are the handlers for specific API versions of the registration, see ForAPIVersion
+	variants map[string]*errorHandler
+
+	// matchesRequest is the predicate of handlers that match on the request, see RegisterRequestMatcherHandlerOn
+	matchesRequest func(err error, request MatchInfo) bool
+
+	// fallback is true if the handler is only used if no other handler matches, see RegisterPackageHandlerOn
+	fallback bool
+
+	// exactType is true if the handler only matches the resolved error itself, see ExactType
+	exactType bool
+
+	// isExactType checks if an error is of the registered type without consulting its As method
+	isExactType func(err error) bool
+
+	// priority decides which registrations are evaluated first, see WithPriority
+	priority int
+
+	// placeholder is true if the registration only exists for its variants, see ForAPIVersion
+	placeholder bool
 }
 
 // NewErrorRegistry instantiates a new ErrorRegistry. If you're looking for the 'default' error
 // registry, check out DefaultErrorRegistry.
 func NewErrorRegistry() *ErrorRegistry {
 	registry := &ErrorRegistry{
-		handlers: make(map[error]*errorHandler),
+		handlers:     make(map[error]*errorHandler),
+		remoteErrors: make(map[string]error),
+		unwrapDepth:  maxUnwrapDepth,
 		defaultHandler: func(context.Context, error) (int, any) {
 			return http.StatusInternalServerError, nil
 		},
@@ -38,54 +92,410 @@ func NewErrorRegistry() *ErrorRegistry {
 	return registry
 }
 
-// ErrorRegistry is the place where errors and callbacks are stored.
+// ErrorRegistry is the place where errors and callbacks are stored. It's safe for concurrent use, handlers can
+// be registered while errors are being resolved.
 type ErrorRegistry struct {
+	// mu guards all fields below, handlers are called without holding it
+	mu sync.RWMutex
+
 	// handlers maps error types with their handlers
 	handlers map[error]*errorHandler
 
+	// order contains the keys of handlers in the order they were first registered, to match deterministically
+	order []error
+
+	// evaluation contains the keys of handlers in the order they are evaluated, see SetMatcherOrder
+	evaluation []error
+
+	// matcherOrder is the order in which the kinds of registrations are evaluated, see SetMatcherOrder
+	matcherOrder []MatcherKind
+
+	// joinStrategy decides which handler is used if multiple handlers match, see SetJoinStrategy
+	joinStrategy JoinStrategy
+
 	// defaultHandler is called if no matching error was registered
 	defaultHandler func(ctx context.Context, err error) (int, any)
+
+	// remoteErrors maps remote error codes to the local errors they are hydrated into
+	remoteErrors map[string]error
+
+	// differenceObserver is called for a sample of matched errors, see RegisterDifferenceObserver
+	differenceObserver func(ctx context.Context, difference Difference)
+
+	// differenceSampleRate is the fraction of matched errors that is passed to differenceObserver
+	differenceSampleRate float64
+
+	// policies are checked against every response of a matched handler, see RegisterPolicy
+	policies []Policy
+
+	// legacyResolver is consulted before the default handler, see SetLegacyResolver
+	legacyResolver func(err error) (int, any, bool)
+
+	// stringErrors is the amount of handlers registered for string errors, for Stats
+	stringErrors int
+
+	// softLimits are the limits after which softLimitWarning is called, see RegisterSoftLimits
+	softLimits SoftLimits
+
+	// softLimitWarning is called when a registration crosses one of the softLimits
+	softLimitWarning func(stats Stats)
+
+	// requestIDSource is used to inject request IDs into responses of gin requests, see SetRequestIDSource
+	requestIDSource RequestIDSource
+
+	// jsonEncoder is used to write JSON responses if set, see SetJSONEncoder
+	jsonEncoder JSONEncoder
+
+	// matchers is the amount of predicates and equality functions that were registered, used to give them unique keys
+	matchers int
+
+	// matchPreference decides if the outermost or innermost match is used, see SetMatchPreference
+	matchPreference MatchPreference
+
+	// unwrapDepth is the maximum depth of the error tree that is searched for matches, see SetMaxUnwrapDepth
+	unwrapDepth int
+
+	// nilHandler is called when a nil error is resolved, see RegisterNilHandler
+	nilHandler func(ctx context.Context) (int, any)
+
+	// aggregateHandler composes the response of errors that aggregate other errors, see SetAggregateHandler
+	aggregateHandler func(ctx context.Context, err error, results []ErrorResponse) (int, any)
+
+	// statusCodeBody builds the body of errors resolved by their own status code, see SetStatusCodeBody
+	statusCodeBody func(ctx context.Context, err error, code int) any
+
+	// strict is true if unmapped errors get a distinctive response, see SetStrictMode
+	strict bool
+
+	// fingerprintHeader is true if the fingerprint is sent in error responses, see SetFingerprintHeader
+	fingerprintHeader bool
+
+	// conflictPolicy decides what happens with conflicting registrations, see SetConflictPolicy
+	conflictPolicy ConflictPolicy
+
+	// conflicts are the registrations that were rejected by ConflictReject, they are reported by Validate
+	conflicts []error
+
+	// parent is consulted for errors no handler matched, see NewErrorRegistryWithParent. It's never changed.
+	parent *ErrorRegistry
 }
 
 func (e *ErrorRegistry) RegisterDefaultHandler(callback func(ctx context.Context, err error) (int, any)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
 	e.defaultHandler = callback
 }
 
-// NewErrorResponse Returns an error response using the DefaultErrorRegistry. If no specific handler could be found,
-// it will return the defaults.
+// callDefaultHandler calls the default handler without holding the lock.
+func (e *ErrorRegistry) callDefaultHandler(ctx context.Context, err error) (int, any) {
+	e.mu.RLock()
+	defaultHandler := e.defaultHandler
+	e.mu.RUnlock()
+
+	return defaultHandler(ctx, err)
+}
+
+// NewErrorResponse Returns an error response using the registry attached to the context (see ContextWithRegistry
+// and WithRegistry) or the DefaultErrorRegistry. If no specific handler could be found, it will return the defaults.
 func NewErrorResponse(ctx context.Context, err error) (int, any) {
-	return NewErrorResponseFrom(ctx, DefaultErrorRegistry, err)
+	return NewErrorResponseFrom(ctx, registryFromContext(ctx), err)
 }
 
 // NewErrorResponseFrom Returns an error response using the given registry. If no specific handler could be found,
 // it will return the defaults.
 func NewErrorResponseFrom[E error](ctx context.Context, registry *ErrorRegistry, err E) (int, any) {
-	for errConcrete, handler := range registry.handlers {
-		// We can't use `errors.As` here directly, as we don't have a concrete version of the type here
-		if !handler.isType(err) {
-			continue
+	code, response, _ := NewErrorResponseWithHeadersFrom(ctx, registry, err)
+
+	return code, response
+}
+
+// NewErrorResponseWithHeaders is like NewErrorResponse, but also returns the headers set by the handler.
+func NewErrorResponseWithHeaders(ctx context.Context, err error) (int, any, http.Header) {
+	return NewErrorResponseWithHeadersFrom(ctx, registryFromContext(ctx), err)
+}
+
+// NewErrorResponseWithHeadersFrom is like NewErrorResponseFrom, but also returns the headers set by the handler,
+// see RegisterErrorHandlerWithHeadersOn. The headers are nil if the handler didn't set any or if the default
+// handler was used.
+func NewErrorResponseWithHeadersFrom[E error](ctx context.Context, registry *ErrorRegistry, err E) (int, any, http.Header) {
+	response := registry.resolveResponse(ctx, err)
+
+	return response.Code, response.Body, response.Headers
+}
+
+// resolveResponse resolves the error into a response, using the nil handler for nil errors (see RegisterNilHandler),
+// or every element of it if it aggregates errors and there's an aggregate handler, see SetAggregateHandler.
+func (e *ErrorRegistry) resolveResponse(ctx context.Context, err error) ErrorResponse {
+	if response, ok := e.resolveNil(ctx, err); ok {
+		return response
+	}
+
+	if response, ok := e.resolveAggregate(ctx, err); ok {
+		return response
+	}
+
+	return e.resolveError(ctx, err)
+}
+
+// resolveError resolves the error into a response, falling back to the status code of the error, the legacy
+// resolver, strict mode and the default handler if no handler matched.
+func (e *ErrorRegistry) resolveError(ctx context.Context, err error) ErrorResponse {
+	code, response, headers, match, ok := e.resolve(ctx, err)
+	if !ok {
+		code, response, headers, match, ok = e.resolveParent(ctx, err)
+	}
+
+	if !ok {
+		if code, response, ok := e.resolveStatusCode(ctx, err); ok {
+			return newErrorResponse(code, response, nil, statusCodeHandlerName)
+		}
+
+		if code, response, ok := e.resolveLegacy(err); ok {
+			return newErrorResponse(code, response, nil, legacyHandlerName)
+		}
+
+		if code, response, headers, ok := e.resolveUnmapped(ctx, err); ok {
+			return newErrorResponse(code, response, headers, unmappedHandlerName)
 		}
 
-		// If it's a string error, it must match the given error exactly, otherwise it might mix up if we only
-		// check on type
-		if handler.isStringError {
-			if errors.Is(err, errConcrete) {
-				// It might be wrapped, so we pass the concrete type
-				return handler.handle(ctx, errConcrete)
+		code, response := e.callDefaultHandler(ctx, err)
+
+		return newErrorResponse(code, response, nil, defaultHandlerName)
+	}
+
+	if violation := e.checkPolicies(match.info(), code, err); violation != nil {
+		code, response := e.callDefaultHandler(ctx, violation)
+
+		return newErrorResponse(code, response, nil, defaultHandlerName)
+	}
+
+	e.observeDifference(ctx, err, code, response)
+
+	return newErrorResponse(code, response, headers, match.name())
+}
+
+// resolve calls the handler matching the error, the boolean is false if no handler matched. If multiple handlers
+// match the error tree, the join strategy decides which one is used, see SetJoinStrategy.
+func (e *ErrorRegistry) resolve(ctx context.Context, err error) (int, any, http.Header, handlerMatch, bool) {
+	e.mu.RLock()
+	strategy := e.joinStrategy
+	e.mu.RUnlock()
+
+	if strategy == JoinWorstStatus || strategy == JoinAggregate {
+		return e.resolveAll(ctx, err, strategy == JoinAggregate)
+	}
+
+	if strategy == JoinHighestPriority {
+		return e.resolveHighestPriority(ctx, err)
+	}
+
+	match, ok := e.match(ctx, err)
+	if !ok {
+		return 0, nil, nil, handlerMatch{}, false
+	}
+
+	code, response, headers := match.call(ctx)
+	if isSkip(response) {
+		return e.resolveSkipped(ctx, err)
+	}
+
+	return code, response, headers, match, true
+}
+
+// handlerMatch is a handler that matched an error in the tree of the resolved error.
+type handlerMatch struct {
+	// key is the key the handler was registered under
+	key error
+
+	// handler is the handler that matched
+	handler *errorHandler
+
+	// node is the error in the tree that matched the handler
+	node error
+
+	// original is the error that is being resolved, the node is part of its tree
+	original error
+}
+
+// call calls the handler with the error that matched.
+func (m handlerMatch) call(ctx context.Context) (int, any, http.Header) {
+	target := m.node
+
+	// It might be wrapped, so we pass the concrete type
+	if m.handler.isStringError {
+		target = m.key
+	}
+
+	handler := m.handler.forVersion(ctx)
+
+	var (
+		code     int
+		response any
+		headers  http.Header
+	)
+
+	if handler.handleOriginal != nil {
+		code, response, headers = handler.handleOriginal(ctx, target, m.original)
+	} else {
+		code, response, headers = handler.handle(ctx, target)
+	}
+
+	return code, response, handler.cacheHeaders(headers)
+}
+
+// info describes the registration of the handler that matched.
+func (m handlerMatch) info() HandlerInfo {
+	return handlerInfo(m.key, m.handler)
+}
+
+// name returns a human-readable description of the registration of the handler that matched.
+func (m handlerMatch) name() string {
+	return describeRegistration(m.key, m.handler)
+}
+
+// match returns the first handler matching the error. The error tree is searched depth-first like errors.As, if
+// multiple handlers match the same error, the first one in the evaluation order is used, see Rules. With
+// PreferInnermost, the handler of the last error in the tree that has one is returned instead. Fallbacks are only
+// returned if no other handler matches any error in the tree.
+func (e *ErrorRegistry) match(ctx context.Context, err error) (handlerMatch, bool) {
+	request := MatchInfoFromContext(ctx)
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var (
+		result        handlerMatch
+		found         bool
+		fallback      handlerMatch
+		fallbackFound bool
+	)
+
+	// The resolved error itself is always visited first
+	root := true
+	innermost := e.matchPreference == PreferInnermost
+
+	walkErrors(err, e.unwrapDepth, func(node error) bool {
+		match, ok := e.matchNode(node, root, request)
+		root = false
+
+		// Fallbacks are evaluated last, so this node has no other match, but other nodes might
+		if ok && match.handler.fallback {
+			if !fallbackFound || innermost {
+				fallback, fallbackFound = match, true
 			}
 
-			continue
+			return false
+		}
+
+		if ok {
+			result, found = match, true
+		}
+
+		// The last match is the innermost one, so we have to search the entire tree
+		return ok && !innermost
+	})
+
+	if !found {
+		result, found = fallback, fallbackFound
+	}
+
+	result.original = err
+
+	return result, found
+}
+
+// matches returns every handler that matches an error in the tree of err, in the order they matched. A handler
+// is only returned for the first error it matched.
+func (e *ErrorRegistry) matches(ctx context.Context, err error) []handlerMatch {
+	request := MatchInfoFromContext(ctx)
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var result []handlerMatch
+
+	seen := make(map[*errorHandler]struct{})
+	root := true
+
+	walkErrors(err, e.unwrapDepth, func(node error) bool {
+		for _, key := range e.evaluation {
+			handler := e.handlers[key]
+
+			if _, ok := seen[handler]; ok || !handler.matchesNode(key, node, root, request) {
+				continue
+			}
+
+			seen[handler] = struct{}{}
+			result = append(result, handlerMatch{key: key, handler: handler, node: node, original: err})
 		}
 
-		return handler.handle(ctx, err)
+		root = false
+
+		return false
+	})
+
+	// Fallbacks are only used if nothing else matched
+	if slices.ContainsFunc(result, func(match handlerMatch) bool { return !match.handler.fallback }) {
+		result = slices.DeleteFunc(result, func(match handlerMatch) bool { return match.handler.fallback })
+	}
+
+	return result
+}
+
+// matchNode returns the first registered handler that matches the node itself, without unwrapping it. Root is
+// true if the node is the resolved error itself, request describes the request it's resolved for. The caller must
+// hold the lock.
+func (e *ErrorRegistry) matchNode(node error, root bool, request MatchInfo) (handlerMatch, bool) {
+	for _, key := range e.evaluation {
+		handler := e.handlers[key]
+
+		if handler.matchesNode(key, node, root, request) {
+			return handlerMatch{key: key, handler: handler, node: node}, true
+		}
+	}
+
+	return handlerMatch{}, false
+}
+
+// matchesNode returns true if the handler registered under key matches the node itself, without unwrapping it.
+// Root is true if the node is the resolved error itself, which is the only error ExactType handlers match. Request
+// describes the request the error is resolved for, see RegisterRequestMatcherHandlerOn.
+func (h *errorHandler) matchesNode(key error, node error, root bool, request MatchInfo) bool {
+	if h.exactType && !root {
+		return false
+	}
+
+	if h.matchesRequest != nil {
+		return h.matchesRequest(node, request)
+	}
+
+	// If it's a string error, it must match the given error exactly, otherwise it might mix up if we only
+	// check on type
+	if h.isStringError {
+		if reflect.ValueOf(node).Comparable() && node == key {
+			return true
+		}
+
+		if h.normalized && normalizeMessage(node.Error()) == h.normalizedMessage {
+			return true
+		}
+
+		matcher, ok := node.(interface{ Is(error) bool })
+
+		return !h.exactType && ok && matcher.Is(key)
+	}
+
+	if h.exactType {
+		return h.isExactType(node)
 	}
 
-	return registry.defaultHandler(ctx, err)
+	return h.isType(node)
 }
 
 // RegisterErrorHandler registers an error handler in DefaultErrorRegistry.
-func RegisterErrorHandler[E error](instance E, handler func(context.Context, E) (int, any)) {
-	RegisterErrorHandlerOn(DefaultErrorRegistry, instance, handler)
+func RegisterErrorHandler[E error](instance E, handler func(context.Context, E) (int, any), options ...RegistrationOption) {
+	RegisterErrorHandlerOn(DefaultErrorRegistry, instance, handler, options...)
 }
 
 // errorStringType is used to check if an error was created by errors.New or fmt.Errorf
@@ -93,27 +503,245 @@ func RegisterErrorHandler[E error](instance E, handler func(context.Context, E)
 //nolint:err113 // We need it here for the type name
 var errorStringType = fmt.Sprintf("%T", errors.New(""))
 
-// RegisterErrorHandlerOn registers an error handler in the given registry.
-func RegisterErrorHandlerOn[E error](registry *ErrorRegistry, instance E, handler func(context.Context, E) (int, any)) {
+// RegisterErrorHandlerOn registers an error handler in the given registry. A nil instance of an interface type
+// registers the interface like RegisterTypeOn, it panics if that interface is error itself, as it would match
+// every error.
+func RegisterErrorHandlerOn[E error](registry *ErrorRegistry, instance E, handler func(context.Context, E) (int, any), options ...RegistrationOption) {
+	RegisterErrorHandlerWithHeadersOn(registry, instance, withoutHeaders(handler), options...)
+}
+
+// RegisterErrorHandlers registers the same error handler for each of the instances in DefaultErrorRegistry.
+func RegisterErrorHandlers[E error](instances []E, handler func(context.Context, E) (int, any), options ...RegistrationOption) {
+	RegisterErrorHandlersOn(DefaultErrorRegistry, instances, handler, options...)
+}
+
+// RegisterErrorHandlersOn registers the same error handler for each of the instances in the given registry, so a
+// family of sentinel errors can share one handler. The handler receives the sentinel that matched. If E is an
+// interface, like in `[]error{ErrA, ErrB}`, every instance is matched like errors.Is instead of by its type, so
+// the handler doesn't match every error of the interface. It panics if such an instance isn't comparable, use
+// RegisterEqualityHandlerOn for those.
+func RegisterErrorHandlersOn[E error](registry *ErrorRegistry, instances []E, handler func(context.Context, E) (int, any), options ...RegistrationOption) {
+	for _, instance := range instances {
+		if reflect.TypeFor[E]().Kind() != reflect.Interface {
+			RegisterErrorHandlerOn(registry, instance, handler, options...)
+
+			continue
+		}
+
+		registry.addHandler(newSentinelHandler(instance, withoutHeaders(handler), options))
+	}
+}
+
+// newSentinelHandler returns the key and errorHandler of a handler for instance that only matches the instance
+// itself, like errors.Is, even if E is an interface.
+func newSentinelHandler[E error](instance E, handler func(context.Context, E) (int, any, http.Header), options []RegistrationOption) (error, *errorHandler) {
+	key, errorHandler := newInstanceHandler(instance, handler, options)
+	if errorHandler.isStringError {
+		return key, errorHandler
+	}
+
+	if !reflect.ValueOf(instance).Comparable() {
+		panic(fmt.Sprintf("ginerr: can't register a handler for sentinel %T, it isn't comparable, use RegisterEqualityHandlerOn instead", instance))
+	}
+
+	matches := func(err error) bool {
+		if reflect.ValueOf(err).Comparable() && err == key {
+			return true
+		}
+
+		matcher, ok := err.(interface{ Is(error) bool })
+
+		return ok && matcher.Is(key)
+	}
+
+	errorHandler.isMatcher = true
+	errorHandler.instance = key
+	errorHandler.isType = matches
+	errorHandler.isExactType = matches
+
+	return key, errorHandler
+}
+
+// RegisterErrorHandlerWithHeaders registers an error handler that also returns response headers in DefaultErrorRegistry.
+func RegisterErrorHandlerWithHeaders[E error](instance E, handler func(context.Context, E) (int, any, http.Header), options ...RegistrationOption) {
+	RegisterErrorHandlerWithHeadersOn(DefaultErrorRegistry, instance, handler, options...)
+}
+
+// RegisterErrorHandlerWithHeadersOn registers an error handler in the given registry that also returns response
+// headers, like Retry-After for a 429 or WWW-Authenticate for a 401. The headers are set by AbortWithError
+// and Respond and can be read with NewErrorResponseWithHeadersFrom.
+func RegisterErrorHandlerWithHeadersOn[E error](registry *ErrorRegistry, instance E, handler func(context.Context, E) (int, any, http.Header), options ...RegistrationOption) {
+	registry.addHandler(newInstanceHandler(instance, handler, options))
+}
+
+// newInstanceHandler returns the key and errorHandler of a handler registered for instance.
+func newInstanceHandler[E error](instance E, handler func(context.Context, E) (int, any, http.Header), options []RegistrationOption) (error, *errorHandler) {
+	key := registrationKey(instance)
+
+	errorHandler := newErrorHandler(fmt.Sprintf("%T", instance) == errorStringType, handler, options)
+	if errorHandler.isStringError && errorHandler.normalized {
+		errorHandler.normalizedMessage = normalizeMessage(key.Error())
+	}
+
+	return key, errorHandler
+}
+
+// withoutHeaders turns a handler without headers into one that returns nil headers. A nil handler stays nil,
+// so Validate can still report it.
+func withoutHeaders[E any](handler func(context.Context, E) (int, any)) func(context.Context, E) (int, any, http.Header) {
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, err E) (int, any, http.Header) {
+		code, response := handler(ctx, err)
+
+		return code, response, nil
+	}
+}
+
+// registrationKey returns the key a handler for instance is stored under. Instances that can't be used as
+// a map key, like nil interfaces, slices (e.g. validator.ValidationErrors) or structs holding a slice error, are
+// stored under their type.
+func registrationKey[E error](instance E) error {
+	if any(instance) == nil {
+		if reflect.TypeFor[E]() == errorInterfaceType {
+			panic("ginerr: can't register a handler for a nil error, it would match every error, use RegisterDefaultHandler instead")
+		}
+
+		return typeKey{reflect.TypeFor[E]()}
+	}
+
+	if !reflect.ValueOf(instance).Comparable() {
+		return typeKey{reflect.TypeFor[E]()}
+	}
+
+	return instance
+}
+
+// RegisterType registers an error handler for the error type E in DefaultErrorRegistry, without requiring an instance.
+func RegisterType[E error](handler func(context.Context, E) (int, any), options ...RegistrationOption) {
+	RegisterTypeOn(DefaultErrorRegistry, handler, options...)
+}
+
+// typeKey is used as the key of handlers registered without an instance, as their zero value might be nil.
+type typeKey struct {
+	reflect.Type
+}
+
+func (t typeKey) Error() string {
+	return t.String()
+}
+
+// RegisterTypeOn registers an error handler for the error type E in the given registry, without requiring an instance.
+// Errors are matched like errors.As, so E may also be an interface.
+func RegisterTypeOn[E error](registry *ErrorRegistry, handler func(context.Context, E) (int, any), options ...RegistrationOption) {
+	registry.addHandler(typeKey{reflect.TypeFor[E]()}, newErrorHandler(false, withoutHeaders(handler), options))
+}
+
+// addHandler stores the handler under the given key, replacing any previous handler, and calls the soft limit
+// warning if the registration crossed one of the limits.
+func (e *ErrorRegistry) addHandler(key error, handler *errorHandler) {
+	e.mu.Lock()
+
+	var conflict error
+	if e.conflictPolicy != ConflictOverwrite {
+		conflict = e.conflict(key, handler)
+	}
+
+	if conflict != nil && e.conflictPolicy != ConflictLog {
+		policy := e.conflictPolicy
+		if policy == ConflictReject {
+			e.conflicts = append(e.conflicts, conflict)
+		}
+
+		e.mu.Unlock()
+
+		reportConflict(policy, conflict)
+
+		return
+	}
+
+	before := e.stats()
+
+	previous, exists := e.handlers[key]
+	if exists && previous.isStringError {
+		e.stringErrors--
+	}
+
+	handler = withVariant(previous, handler)
+	if handler.isStringError {
+		e.stringErrors++
+	}
+
+	e.handlers[key] = handler
+
+	if !exists {
+		e.order = append(e.order, key)
+	}
+
+	e.updateEvaluation()
+
+	after := e.stats()
+	limits, warn := e.softLimits, e.softLimitWarning
+
+	e.mu.Unlock()
+
+	if conflict != nil {
+		reportConflict(ConflictLog, conflict)
+	}
+
+	if warn != nil && limits.crossed(before, after) {
+		warn(after)
+	}
+}
+
+// newErrorHandler creates an errorHandler that matches errors of type E.
+func newErrorHandler[E any](isStringError bool, handler func(context.Context, E) (int, any, http.Header), options []RegistrationOption) *errorHandler {
 	// Wrap it in a closure, we can't save it directly because err E is not available in NewErrorResponseFrom. It will
 	// be available in the closure when it is called. Check out TestErrorResponseFrom_ReturnsErrorBInInterface for an example.
-	registry.handlers[instance] = &errorHandler{
+	result := &errorHandler{
 		// Necessary to make sure we match error strings using `errors.Is`
-		isStringError: fmt.Sprintf("%T", instance) == errorStringType,
+		isStringError: isStringError,
 
-		// Handler that uses errors.As to cast to an error
-		handle: func(ctx context.Context, err error) (int, any) {
+		errorType: reflect.TypeFor[E](),
+		isNil:     handler == nil,
+
+		// Handler that uses errorsAs to cast to an error
+		handle: func(ctx context.Context, err error) (int, any, http.Header) {
 			var errorOfType E
 
-			// This function should only be called if errors.Is succeeded, so this should never fail
-			_ = errors.As(err, &errorOfType)
+			// This function should only be called if errorsAs succeeded, so this should never fail
+			_ = errorsAs(err, &errorOfType)
 
 			return handler(ctx, errorOfType)
 		},
 
-		// Type check, as we need `instance` from this function
+		// Type check without As methods, for ExactType
+		isExactType: func(err error) bool {
+			_, ok := err.(E)
+
+			return ok
+		},
+
+		// Type check, as we need the type information of E from this function. Only the error itself is
+		// checked, the registry walks the tree.
 		isType: func(err error) bool {
-			return errors.As(err, &instance)
+			if _, ok := err.(E); ok {
+				return true
+			}
+
+			var target E
+
+			matcher, ok := err.(interface{ As(any) bool })
+
+			return ok && matcher.As(&target)
 		},
 	}
+
+	for _, option := range options {
+		option(result)
+	}
+
+	return result
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -56,6 +57,28 @@ func TestErrorResponse_UsesDefaultErrorRegistry(t *testing.T) {
 	assert.Equal(t, expectedResponse, response)
 }
 
+//nolint:paralleltest // Can't be used, we use global variables
+func TestRegisterType_UsesDefaultErrorRegistry(t *testing.T) {
+	// Arrange
+	var calledWithError *BError
+	callback := func(_ context.Context, err *BError) (int, any) {
+		calledWithError = err
+		return 635, "type"
+	}
+
+	err := &BError{message: "It was the man with one hand!"}
+
+	RegisterType(callback)
+
+	// Act
+	code, response := NewErrorResponse(context.Background(), err)
+
+	// Assert
+	assert.Equal(t, err, calledWithError)
+	assert.Equal(t, 635, code)
+	assert.Equal(t, "type", response)
+}
+
 // These are parallel because it uses the 'from' variant
 
 func TestErrorResponseFrom_ReturnsNullOnNoDefaultErrorDefined(t *testing.T) {
@@ -281,3 +304,225 @@ func TestErrorResponseFrom_ReturnsGenericErrorOnTypeNotFound(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, code)
 	assert.Nil(t, response)
 }
+
+func TestRegisterTypeOn_MatchesWrappedErrorsOfType(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithErr *AError
+	callback := func(_ context.Context, err *AError) (int, any) {
+		calledWithErr = err
+		return http.StatusConflict, "conflict"
+	}
+
+	RegisterTypeOn(registry, callback)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", &AError{message: "abc"}))
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, code)
+	assert.Equal(t, "conflict", response)
+	assert.Equal(t, &AError{message: "abc"}, calledWithErr)
+}
+
+type temporaryError interface {
+	error
+	Temporary() bool
+}
+
+type timeoutError interface {
+	error
+	Timeout() bool
+}
+
+type dummyTemporaryError struct{}
+
+func (dummyTemporaryError) Error() string {
+	return "temporary"
+}
+
+func (dummyTemporaryError) Temporary() bool {
+	return true
+}
+
+func TestRegisterTypeOn_MatchesInterfaces(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithErr temporaryError
+	RegisterTypeOn(registry, func(_ context.Context, err temporaryError) (int, any) {
+		calledWithErr = err
+		return http.StatusServiceUnavailable, "temporary"
+	})
+
+	// Registered with the nil value of a different interface, to make sure they don't overwrite each other
+	RegisterTypeOn(registry, func(context.Context, timeoutError) (int, any) {
+		return http.StatusGatewayTimeout, "timeout"
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", dummyTemporaryError{}))
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "temporary", response)
+	assert.Equal(t, dummyTemporaryError{}, calledWithErr)
+}
+
+func TestNewErrorResponseWithHeadersFrom_ReturnsHandlerHeaders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerWithHeadersOn(registry, &AError{}, func(context.Context, *AError) (int, any, http.Header) {
+		return http.StatusUnauthorized, "unauthorized", http.Header{"Www-Authenticate": []string{`Bearer realm="api"`}}
+	})
+
+	// Act
+	code, response, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", &AError{}))
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Equal(t, "unauthorized", response)
+	assert.Equal(t, `Bearer realm="api"`, headers.Get("WWW-Authenticate"))
+}
+
+func TestNewErrorResponseWithHeadersFrom_ReturnsNilHeadersOnDefaultHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	code, response, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, &BError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+	assert.Nil(t, headers)
+}
+
+func TestNewErrorResponseFrom_IgnoresHandlerHeaders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerWithHeadersOn(registry, &AError{}, func(context.Context, *AError) (int, any, http.Header) {
+		return http.StatusTooManyRequests, "slow down", RetryAfterHeader(time.Minute)
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Equal(t, "slow down", response)
+}
+
+func TestRegisterErrorHandlersOn_RegistersEverySentinel(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNoAccount := errors.New("no account")
+	errNoCard := errors.New("no card")
+	errNoLoan := errors.New("no loan")
+
+	RegisterErrorHandlersOn(registry, []error{errNoAccount, errNoCard, errNoLoan}, func(_ context.Context, err error) (int, any) {
+		return http.StatusNotFound, err.Error()
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"first": {
+			err:              errNoAccount,
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: "no account",
+		},
+		"wrapped": {
+			err:              fmt.Errorf("loading: %w", errNoLoan),
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: "no loan",
+		},
+		"other": {
+			err:          errors.New("no card"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterErrorHandlersOn_MatchesNonStringSentinelsByIdentity(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errConflict := &AError{message: "conflict"}
+
+	RegisterErrorHandlersOn(registry, []error{errConflict, errors.New("duplicate")}, func(context.Context, error) (int, any) {
+		return http.StatusConflict, nil
+	})
+
+	tests := map[string]struct {
+		err          error
+		expectedCode int
+	}{
+		"sentinel": {
+			err:          fmt.Errorf("saving: %w", errConflict),
+			expectedCode: http.StatusConflict,
+		},
+		"same type": {
+			err:          &AError{message: "conflict"},
+			expectedCode: http.StatusInternalServerError,
+		},
+		"unrelated": {
+			err:          &BError{},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+
+	assert.NoError(t, registry.Validate())
+}
+
+func TestRegisterErrorHandlersOn_PanicsOnSentinelsThatCantBeCompared(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterErrorHandlersOn(registry, []error{sliceError{"a"}}, func(context.Context, error) (int, any) {
+			return http.StatusConflict, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for sentinel ginerr.sliceError, it isn't comparable, use RegisterEqualityHandlerOn instead", result)
+}
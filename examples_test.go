@@ -78,3 +78,24 @@ func ExampleRegisterErrorHandlerOn() {
 	// 400: Your input was invalid: ...
 	// 502: please try again later
 }
+
+func ExampleRegisterTypeOn() {
+	registry := NewErrorRegistry()
+
+	// Register the error handler, the type is derived from the handler
+	RegisterTypeOn(registry, func(_ context.Context, err *InputValidationError) (int, any) {
+		return http.StatusBadRequest, "Your input was invalid: " + err.Error()
+	})
+
+	// Return errors somewhere deep in your code
+	err := fmt.Errorf("validation error: %w", &InputValidationError{})
+
+	// In your HTTP handlers, instantiate responses and return those to the users
+	code, response := NewErrorResponseFrom(context.Background(), registry, err)
+
+	// Check the output
+	fmt.Printf("%d: %s\n", code, response)
+
+	// Output:
+	// 400: Your input was invalid: ...
+}
@@ -0,0 +1,60 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+)
+
+// Exit codes as defined in sysexits.h, used by ResolveExitFrom.
+const (
+	ExitOK          = 0
+	ExitDataErr     = 65
+	ExitNoInput     = 66
+	ExitUnavailable = 69
+	ExitSoftware    = 70
+	ExitTempFail    = 75
+	ExitNoPerm      = 77
+)
+
+// ResolveExit returns a process exit code and a message for stderr using the registry attached to the context or
+// the DefaultErrorRegistry.
+func ResolveExit(ctx context.Context, err error) (int, string) {
+	return ResolveExitFrom(ctx, registryFromContext(ctx), err)
+}
+
+// ResolveExitFrom returns a process exit code and a message for stderr using the given registry, so CLIs and jobs
+// can reuse the handlers of the HTTP layer. The status code of the response is mapped using ExitCodeFromStatus and
+// the response is used as the message, falling back to the status text if it's empty.
+func ResolveExitFrom[E error](ctx context.Context, registry *ErrorRegistry, err E) (int, string) {
+	code, response := NewErrorResponseFrom(ctx, registry, err)
+
+	message := responseText(response)
+	if message == "" {
+		message = http.StatusText(code)
+	}
+
+	return ExitCodeFromStatus(code), message
+}
+
+// ExitCodeFromStatus maps an HTTP status code to the sysexits.h exit code that describes it best. Statuses below
+// 400 result in ExitSoftware rather than ExitOK, as they can only come from a handler that mapped an error to a
+// successful status and a process must not exit successfully because of an error.
+func ExitCodeFromStatus(status int) int {
+	switch {
+	case status < http.StatusBadRequest:
+		return ExitSoftware
+	case status == http.StatusUnauthorized, status == http.StatusForbidden:
+		return ExitNoPerm
+	case status == http.StatusNotFound, status == http.StatusGone:
+		return ExitNoInput
+	case status == http.StatusRequestTimeout, status == http.StatusTooManyRequests,
+		status == http.StatusServiceUnavailable, status == http.StatusGatewayTimeout:
+		return ExitTempFail
+	case status == http.StatusBadGateway:
+		return ExitUnavailable
+	case status < http.StatusInternalServerError:
+		return ExitDataErr
+	default:
+		return ExitSoftware
+	}
+}
@@ -0,0 +1,85 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveExitFrom_ReturnsExitCodeAndMessage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusNotFound, "order not found"
+	})
+
+	// Act
+	code, message := ResolveExitFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, ExitNoInput, code)
+	assert.Equal(t, "order not found", message)
+}
+
+func TestResolveExitFrom_ReturnsStatusTextOnEmptyResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	code, message := ResolveExitFrom(context.Background(), registry, assert.AnError)
+
+	// Assert
+	assert.Equal(t, ExitSoftware, code)
+	assert.Equal(t, "Internal Server Error", message)
+}
+
+func TestResolveExitFrom_NeverExitsSuccessfullyOnErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusOK, "already processed"
+	})
+
+	// Act
+	code, message := ResolveExitFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, ExitSoftware, code)
+	assert.Equal(t, "already processed", message)
+}
+
+func TestExitCodeFromStatus_ReturnsExpectedExitCodes(t *testing.T) {
+	t.Parallel()
+	tests := map[int]int{
+		http.StatusOK:                  ExitSoftware,
+		http.StatusNoContent:           ExitSoftware,
+		http.StatusBadRequest:          ExitDataErr,
+		http.StatusUnauthorized:        ExitNoPerm,
+		http.StatusForbidden:           ExitNoPerm,
+		http.StatusNotFound:            ExitNoInput,
+		http.StatusConflict:            ExitDataErr,
+		http.StatusTooManyRequests:     ExitTempFail,
+		http.StatusInternalServerError: ExitSoftware,
+		http.StatusBadGateway:          ExitUnavailable,
+		http.StatusServiceUnavailable:  ExitTempFail,
+		http.StatusGatewayTimeout:      ExitTempFail,
+	}
+
+	for status, expected := range tests {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := ExitCodeFromStatus(status)
+
+			// Assert
+			assert.Equal(t, expected, result)
+		})
+	}
+}
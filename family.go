@@ -0,0 +1,49 @@
+package ginerr
+
+import (
+	"context"
+	"reflect"
+)
+
+// ErrorFamily is a sentinel error that represents a family of errors, like "any 4xx upstream error". Its Is method
+// reports whether an error belongs to the family.
+type ErrorFamily interface {
+	error
+	Is(err error) bool
+}
+
+// RegisterFamilyHandler registers an error handler for the errors of a family in DefaultErrorRegistry.
+func RegisterFamilyHandler(family ErrorFamily, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterFamilyHandlerOn(DefaultErrorRegistry, family, handler, options...)
+}
+
+// RegisterFamilyHandlerOn registers an error handler in the given registry for the errors of a family. An error
+// belongs to the family if family.Is returns true for it, or if errors.Is would match it with the family as the
+// target, so both directions of a custom Is method work. errors.Is itself only calls the Is method of the errors
+// in the tree, which is why a family registered with RegisterErrorHandlerOn is matched by its type instead. The
+// handler receives the error that belongs to the family. It panics if the family is nil or isn't comparable.
+func RegisterFamilyHandlerOn(registry *ErrorRegistry, family ErrorFamily, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	if family == nil {
+		panic("ginerr: can't register a handler for a nil family")
+	}
+
+	if !reflect.ValueOf(family).Comparable() {
+		panic("ginerr: can't register a handler for a family that isn't comparable, use a pointer instead")
+	}
+
+	matches := func(err error) bool {
+		if family.Is(err) {
+			return true
+		}
+
+		if reflect.ValueOf(err).Comparable() && err == family {
+			return true
+		}
+
+		matcher, ok := err.(interface{ Is(error) bool })
+
+		return ok && matcher.Is(family)
+	}
+
+	registry.addHandler(family, newMatcherHandler(matches, handler, options))
+}
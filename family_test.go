@@ -0,0 +1,152 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// upstreamError is returned by calls to an upstream
+type upstreamError struct {
+	status int
+}
+
+func (e *upstreamError) Error() string {
+	return fmt.Sprintf("upstream returned %d", e.status)
+}
+
+// clientErrorFamily contains every upstreamError with a 4xx status
+type clientErrorFamily struct{}
+
+func (clientErrorFamily) Error() string {
+	return "upstream client error"
+}
+
+func (clientErrorFamily) Is(err error) bool {
+	var upstreamErr *upstreamError
+
+	return errors.As(err, &upstreamErr) && upstreamErr.status >= 400 && upstreamErr.status < 500
+}
+
+// taggedError claims to be part of the family with its own Is method
+type taggedError struct{}
+
+func (taggedError) Error() string {
+	return "tagged"
+}
+
+func (taggedError) Is(err error) bool {
+	return err == clientErrorFamily{}
+}
+
+// valueWrappingFamily is a family that holds an error that might not be comparable
+type valueWrappingFamily struct {
+	valueWrappingError
+}
+
+func (valueWrappingFamily) Is(error) bool {
+	return false
+}
+
+func TestRegisterFamilyHandlerOn_MatchesFamilyMembers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterFamilyHandlerOn(registry, clientErrorFamily{}, func(_ context.Context, err error) (int, any) {
+		return http.StatusBadGateway, err.Error()
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"family Is": {
+			err:              fmt.Errorf("fetching user: %w", &upstreamError{status: http.StatusNotFound}),
+			expectedCode:     http.StatusBadGateway,
+			expectedResponse: "fetching user: upstream returned 404",
+		},
+		"error Is": {
+			err:              taggedError{},
+			expectedCode:     http.StatusBadGateway,
+			expectedResponse: "tagged",
+		},
+		"family itself": {
+			err:              clientErrorFamily{},
+			expectedCode:     http.StatusBadGateway,
+			expectedResponse: "upstream client error",
+		},
+		"not a member": {
+			err:          &upstreamError{status: http.StatusServiceUnavailable},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterFamilyHandlerOn_IsDescribedByFamily(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterFamilyHandlerOn(registry, clientErrorFamily{}, func(context.Context, error) (int, any) {
+		return http.StatusBadGateway, nil
+	})
+
+	// Act
+	rules := registry.Rules()
+
+	// Assert
+	require.Len(t, rules, 1)
+	assert.Equal(t, clientErrorFamily{}, rules[0].Error)
+	assert.Equal(t, "upstream client error", ResolveFrom(context.Background(), registry, clientErrorFamily{}).HandlerName)
+}
+
+func TestRegisterFamilyHandlerOn_PanicsOnInvalidFamily(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterFamilyHandlerOn(registry, nil, func(context.Context, error) (int, any) {
+			return http.StatusBadGateway, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for a nil family", result)
+}
+
+func TestRegisterFamilyHandlerOn_PanicsOnUnhashableFamily(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterFamilyHandlerOn(registry, valueWrappingFamily{valueWrappingError{err: sliceError{}}}, func(context.Context, error) (int, any) {
+			return http.StatusBadGateway, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for a family that isn't comparable, use a pointer instead", result)
+}
@@ -0,0 +1,61 @@
+package ginerr
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
+	"strings"
+)
+
+// FingerprintHeader is the header in which the fingerprint of the registry is sent, see SetFingerprintHeader.
+const FingerprintHeader = "X-Ginerr-Fingerprint"
+
+// SetFingerprintHeader enables or disables sending the Fingerprint of the registry in the FingerprintHeader of error
+// responses written to gin requests, to find replicas that run a different error catalog. It's disabled by default,
+// as the header reveals which version of the catalog a service runs and the fingerprint is calculated for every
+// response.
+func (e *ErrorRegistry) SetFingerprintHeader(enabled bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.fingerprintHeader = enabled
+}
+
+// getFingerprintHeader returns true if the fingerprint is sent in error responses, see SetFingerprintHeader.
+func (e *ErrorRegistry) getFingerprintHeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.fingerprintHeader
+}
+
+// Fingerprint returns a stable hash of the registrations in the registry: the registered types, string errors and
+// remote error codes. It can be used to verify that all replicas of a service run the same error catalog. Handlers
+// are functions, so changes to their responses don't change the fingerprint.
+func (e *ErrorRegistry) Fingerprint() string {
+	hash := sha256.Sum256([]byte(strings.Join(e.registrations(), "\n")))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// registrations returns a sorted description of every registration in the registry.
+func (e *ErrorRegistry) registrations() []string {
+	e.mu.RLock()
+
+	lines := make([]string, 0, len(e.handlers)+len(e.remoteErrors))
+
+	for errConcrete, handler := range e.handlers {
+		lines = append(lines, "handler "+describeRegistration(errConcrete, handler))
+	}
+
+	for code, err := range e.remoteErrors {
+		lines = append(lines, "remote "+code+" "+err.Error())
+	}
+
+	e.mu.RUnlock()
+
+	// The registrations are stored in maps, so they have to be sorted for a stable result
+	slices.Sort(lines)
+
+	return lines
+}
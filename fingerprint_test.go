@@ -0,0 +1,98 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFingerprint_ReturnsSameFingerprintForSameRegistrations(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	newRegistry := func(first, second string) *ErrorRegistry {
+		registry := NewErrorRegistry()
+
+		RegisterErrorHandlerOn(registry, errors.New(first), func(context.Context, error) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+		RegisterErrorHandlerOn(registry, errors.New(second), func(context.Context, error) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+		RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+		RegisterRemoteErrorOn(registry, "CODE", errors.New("remote"))
+
+		return registry
+	}
+
+	// Act
+	resultA := newRegistry("a", "b").Fingerprint()
+	resultB := newRegistry("b", "a").Fingerprint()
+
+	// Assert
+	assert.Len(t, resultA, 64)
+	assert.Equal(t, resultA, resultB)
+}
+
+func TestFingerprint_ReturnsDifferentFingerprintForDifferentRegistrations(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registryA := NewErrorRegistry()
+	RegisterTypeOn(registryA, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	registryB := NewErrorRegistry()
+	RegisterTypeOn(registryB, func(context.Context, *BError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	resultA := registryA.Fingerprint()
+	resultB := registryB.Fingerprint()
+	resultEmpty := NewErrorRegistry().Fingerprint()
+
+	// Assert
+	assert.NotEqual(t, resultA, resultB)
+	assert.NotEqual(t, resultA, resultEmpty)
+}
+
+func TestAbortWithErrorFrom_SetsFingerprintHeaderIfEnabled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetFingerprintHeader(true)
+
+	engine := newTestEngine(func(c *gin.Context) {
+		AbortWithErrorFrom(c, registry, assert.AnError)
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, registry.Fingerprint(), recorder.Header().Get(FingerprintHeader))
+}
+
+//nolint:paralleltest // Can't be used, the gin mode is global
+func TestAbortWithErrorFrom_DoesNotSetFingerprintHeaderByDefault(t *testing.T) {
+	// Arrange
+	engine := newTestEngine(func(c *gin.Context) {
+		AbortWithErrorFrom(c, NewErrorRegistry(), assert.AnError)
+	})
+
+	// Debug mode used to enable it
+	gin.SetMode(gin.DebugMode)
+	defer gin.SetMode(gin.TestMode)
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Empty(t, recorder.Header().Get(FingerprintHeader))
+}
@@ -0,0 +1,234 @@
+package ginerr
+
+import (
+	"context"
+	"encoding/xml"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/gin-gonic/gin/render"
+	"gopkg.in/yaml.v3"
+)
+
+// WrapHandler turns a gin handler that returns an error into a gin.HandlerFunc using the registry attached to the
+// request (see WithRegistry) or the DefaultErrorRegistry.
+func WrapHandler(handler func(c *gin.Context) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := handler(c); err != nil {
+			AbortWithError(c, err)
+		}
+	}
+}
+
+// WrapHandlerFrom turns a gin handler that returns an error into a gin.HandlerFunc using the given registry. If
+// the handler returns an error, it's resolved using the registry and the response is written as JSON.
+func WrapHandlerFrom(registry *ErrorRegistry, handler func(c *gin.Context) error) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := handler(c); err != nil {
+			AbortWithErrorFrom(c, registry, err)
+		}
+	}
+}
+
+// AbortWithError resolves the error using the registry attached to the request (see WithRegistry) or the
+// DefaultErrorRegistry, and aborts the request with the response.
+func AbortWithError(c *gin.Context, err error) {
+	AbortWithErrorFrom(c, registryFromContext(c), err)
+}
+
+// AbortWithErrorFrom resolves the error using the given registry and aborts the request with the response.
+func AbortWithErrorFrom(c *gin.Context, registry *ErrorRegistry, err error) {
+	abortWithErrorFrom(c, registry, err)
+}
+
+// abortWithErrorFrom is AbortWithErrorFrom, but returns the response the error resolved into, so middleware can
+// report what was written.
+func abortWithErrorFrom(c *gin.Context, registry *ErrorRegistry, err error) ErrorResponse {
+	response := newGinErrorResponse(c, registry, err)
+
+	abortWithResponse(c, response.Code, response.Body, jsonWriter(c, registry.getJSONEncoder()))
+
+	return response
+}
+
+// jsonWriter returns a function that writes responses as JSON, using gin's encoder if encoder is nil.
+func jsonWriter(c *gin.Context, encoder JSONEncoder) func(code int, response any) {
+	if encoder == nil {
+		return c.JSON
+	}
+
+	return func(code int, response any) {
+		body, err := encoder.Marshal(response)
+		if err != nil {
+			_ = c.Error(err)
+			c.Status(http.StatusInternalServerError)
+
+			return
+		}
+
+		c.Data(code, "application/json; charset=utf-8", body)
+	}
+}
+
+// Respond resolves the error using the registry attached to the request (see WithRegistry) or the
+// DefaultErrorRegistry, and aborts the request with the response serialized as JSON, XML or YAML depending
+// on the Accept header.
+func Respond(c *gin.Context, err error) {
+	RespondFrom(c, registryFromContext(c), err)
+}
+
+// RespondFrom resolves the error using the given registry, and aborts the request with the response serialized
+// as JSON, XML or YAML depending on the Accept header. JSON is used if the client has no preference, if it
+// accepts none of these formats, like browsers that prefer HTML, or if the response can't be serialized as XML or
+// YAML, like maps can't be serialized as XML.
+func RespondFrom(c *gin.Context, registry *ErrorRegistry, err error) {
+	response := newGinErrorResponse(c, registry, err)
+
+	abortWithResponse(c, response.Code, response.Body, negotiateWriter(c, registry.getJSONEncoder()))
+}
+
+// negotiatedFormats are the formats Respond can write, the first one is used if the client has no preference
+var negotiatedFormats = []string{binding.MIMEJSON, binding.MIMEXML, binding.MIMEYAML}
+
+// negotiateWriter returns a function that writes responses in the format preferred by the client, falling back
+// to JSON using the encoder if the client accepts none of the formats or the response can't be serialized.
+func negotiateWriter(c *gin.Context, encoder JSONEncoder) func(code int, response any) {
+	writeJSON := jsonWriter(c, encoder)
+
+	return func(code int, response any) {
+		var (
+			contentType string
+			body        []byte
+			err         error
+		)
+
+		switch c.NegotiateFormat(negotiatedFormats...) {
+		case binding.MIMEXML:
+			contentType = "application/xml; charset=utf-8"
+			body, err = xml.Marshal(response)
+
+		case binding.MIMEYAML:
+			contentType = "application/yaml; charset=utf-8"
+			body, err = yaml.Marshal(response)
+
+		default:
+			writeJSON(code, response)
+
+			return
+		}
+
+		if err != nil {
+			writeJSON(code, response)
+
+			return
+		}
+
+		c.Data(code, contentType, body)
+	}
+}
+
+// newGinErrorResponse resolves the error for a gin request, sets the headers returned by the handler and injects
+// the request ID (see SetRequestIDSource) and the fingerprint of the registry, see SetFingerprintHeader.
+func newGinErrorResponse(c *gin.Context, registry *ErrorRegistry, err error) ErrorResponse {
+	setGinError(c, err)
+
+	if registry.getFingerprintHeader() {
+		c.Header(FingerprintHeader, registry.Fingerprint())
+	}
+
+	response := registry.resolveResponse(c, err)
+
+	for key, values := range response.Headers {
+		c.Writer.Header().Del(key)
+
+		for _, value := range values {
+			c.Writer.Header().Add(key, value)
+		}
+	}
+
+	response.Body = registry.injectRequestID(c, response.Body)
+
+	return response
+}
+
+// abortWithResponse aborts the request and writes the response using write. Nil responses are written without a
+// body and responses that implement render.Render are rendered as-is, so handlers can return CSV, HTML or proto.
+// If a response was already written, nothing is written and a warning is logged with slog instead, as writing
+// again would only cause a "superfluous response.WriteHeader" error.
+func abortWithResponse(c *gin.Context, code int, response any, write func(code int, response any)) {
+	if c.Writer.Written() {
+		c.Abort()
+
+		slog.WarnContext(c, "ginerr: response was already written, skipping error response",
+			slog.Int("status", code),
+			slog.Int("writtenStatus", c.Writer.Status()),
+		)
+
+		return
+	}
+
+	switch typedResponse := response.(type) {
+	case nil:
+		c.AbortWithStatus(code)
+
+	case render.Render:
+		c.Abort()
+		c.Render(code, typedResponse)
+
+	default:
+		c.Abort()
+		write(code, response)
+	}
+}
+
+// RegisterGinErrorHandler registers an error handler that receives the *gin.Context in DefaultErrorRegistry.
+func RegisterGinErrorHandler[E error](instance E, handler func(*gin.Context, E) (int, any), options ...RegistrationOption) {
+	RegisterGinErrorHandlerOn(DefaultErrorRegistry, instance, handler, options...)
+}
+
+// RegisterGinErrorHandlerOn registers an error handler that receives the *gin.Context in the given registry, so
+// it can read headers, the client IP and route params. The gin context is nil if the error was resolved
+// with a context that doesn't belong to a gin request.
+func RegisterGinErrorHandlerOn[E error](registry *ErrorRegistry, instance E, handler func(*gin.Context, E) (int, any), options ...RegistrationOption) {
+	RegisterErrorHandlerOn(registry, instance, withGinContext(handler), options...)
+}
+
+// withGinContext turns a handler that receives the *gin.Context into a regular handler. A nil handler stays nil,
+// so Validate can still report it.
+func withGinContext[E error](handler func(*gin.Context, E) (int, any)) func(context.Context, E) (int, any) {
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, err E) (int, any) {
+		return handler(ginContextFrom(ctx), err)
+	}
+}
+
+// ginContextFrom returns the gin context a context belongs to, or nil if it doesn't belong to a gin request.
+func ginContextFrom(ctx context.Context) *gin.Context {
+	if c, ok := ctx.(*gin.Context); ok {
+		return c
+	}
+
+	c, _ := ctx.Value(gin.ContextKey).(*gin.Context)
+
+	return c
+}
+
+// requestContextValue returns the value of key in the context, or else in the context of the request of the gin
+// request the context belongs to, as gin contexts don't look in the request context without ContextWithFallback.
+func requestContextValue(ctx context.Context, key any) any {
+	if value := ctx.Value(key); value != nil {
+		return value
+	}
+
+	c := ginContextFrom(ctx)
+	if c == nil || c.Request == nil {
+		return nil
+	}
+
+	return c.Request.Context().Value(key)
+}
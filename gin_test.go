@@ -0,0 +1,402 @@
+package ginerr
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/render"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestEngine creates a gin engine in test mode that serves the given handlers on GET /
+func newTestEngine(handlers ...gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.GET("/", handlers...)
+
+	return engine
+}
+
+// serveTestRequest serves a GET / request on the given engine
+func serveTestRequest(engine *gin.Engine) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+	return recorder
+}
+
+func TestWrapHandlerFrom_WritesErrorResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithCtx context.Context
+	RegisterErrorHandlerOn(registry, &AError{}, func(ctx context.Context, err *AError) (int, any) {
+		calledWithCtx = ctx
+		return http.StatusBadRequest, map[string]string{"error": err.message}
+	})
+
+	var ginCtx *gin.Context
+	engine := newTestEngine(WrapHandlerFrom(registry, func(c *gin.Context) error {
+		ginCtx = c
+		return &AError{message: "invalid"}
+	}))
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `{"error":"invalid"}`, recorder.Body.String())
+	assert.Equal(t, ginCtx, calledWithCtx)
+}
+
+func TestWrapHandlerFrom_WritesNoBodyOnNilResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	engine := newTestEngine(WrapHandlerFrom(NewErrorRegistry(), func(*gin.Context) error {
+		return assert.AnError
+	}))
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestWrapHandlerFrom_DoesNothingOnNoError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	engine := newTestEngine(WrapHandlerFrom(NewErrorRegistry(), func(c *gin.Context) error {
+		c.String(http.StatusOK, "ok")
+		return nil
+	}))
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "ok", recorder.Body.String())
+}
+
+func TestWrapHandlerFrom_AbortsOnError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var nextCalled bool
+	engine := newTestEngine(
+		WrapHandlerFrom(NewErrorRegistry(), func(*gin.Context) error {
+			return assert.AnError
+		}),
+		func(*gin.Context) {
+			nextCalled = true
+		},
+	)
+
+	// Act
+	_ = serveTestRequest(engine)
+
+	// Assert
+	assert.False(t, nextCalled)
+}
+
+func TestRegisterGinErrorHandlerOn_PassesGinContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterGinErrorHandlerOn(registry, &AError{}, func(c *gin.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, err.message + " " + c.GetHeader("X-Test")
+	})
+
+	engine := newTestEngine(WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{message: "header"}
+	}))
+
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	request.Header.Set("X-Test", "value")
+	recorder := httptest.NewRecorder()
+
+	// Act
+	engine.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `"header value"`, recorder.Body.String())
+}
+
+func TestRegisterGinErrorHandlerOn_IsReportedByValidateIfNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterGinErrorHandlerOn[*AError](registry, &AError{}, nil)
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNilHandler)
+}
+
+func TestRegisterGinErrorHandlerOn_PassesGinContextFromDerivedContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithCtx *gin.Context
+	RegisterGinErrorHandlerOn(registry, &AError{}, func(c *gin.Context, _ *AError) (int, any) {
+		calledWithCtx = c
+		return http.StatusBadRequest, nil
+	})
+
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	ctx := context.WithValue(c, dummyContextKey("abc"), "def")
+
+	// Act
+	_, _ = NewErrorResponseFrom(ctx, registry, &AError{})
+
+	// Assert
+	assert.Same(t, c, calledWithCtx)
+}
+
+func TestRegisterGinErrorHandlerOn_PassesNilOnNoGinContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	called := false
+	RegisterGinErrorHandlerOn(registry, &AError{}, func(c *gin.Context, _ *AError) (int, any) {
+		called = true
+		assert.Nil(t, c)
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.True(t, called)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+type negotiatedResponse struct {
+	Message string `json:"message" xml:"message" yaml:"message"`
+}
+
+func TestRespondFrom_NegotiatesContentType(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, &negotiatedResponse{Message: err.message}
+	})
+
+	engine := newTestEngine(func(c *gin.Context) {
+		RespondFrom(c, registry, &AError{message: "invalid"})
+	})
+
+	tests := map[string]struct {
+		expectedContentType string
+		expectedBody        string
+	}{
+		"":                   {expectedContentType: "application/json; charset=utf-8", expectedBody: `{"message":"invalid"}`},
+		"application/json":   {expectedContentType: "application/json; charset=utf-8", expectedBody: `{"message":"invalid"}`},
+		"application/xml":    {expectedContentType: "application/xml; charset=utf-8", expectedBody: `<negotiatedResponse><message>invalid</message></negotiatedResponse>`},
+		"application/x-yaml": {expectedContentType: "application/yaml; charset=utf-8", expectedBody: "message: invalid\n"},
+		"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8": {
+			expectedContentType: "application/xml; charset=utf-8",
+			expectedBody:        `<negotiatedResponse><message>invalid</message></negotiatedResponse>`,
+		},
+	}
+
+	for accept, testData := range tests {
+		t.Run(accept, func(t *testing.T) {
+			t.Parallel()
+			request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			request.Header.Set("Accept", accept)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			engine.ServeHTTP(recorder, request)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Equal(t, testData.expectedContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, testData.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestRespondFrom_FallsBackToJSON(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Maps can't be serialized as XML
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusNotFound, map[string]string{"message": err.message}
+	})
+
+	engine := newTestEngine(func(c *gin.Context) {
+		RespondFrom(c, registry, &AError{message: "not found"})
+	})
+
+	tests := []string{
+		"text/html",
+		"text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+		"application/xml",
+	}
+
+	for _, accept := range tests {
+		t.Run(accept, func(t *testing.T) {
+			t.Parallel()
+			request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			request.Header.Set("Accept", accept)
+			recorder := httptest.NewRecorder()
+
+			// Act
+			engine.ServeHTTP(recorder, request)
+
+			// Assert
+			assert.Equal(t, http.StatusNotFound, recorder.Code)
+			assert.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+			assert.JSONEq(t, `{"message":"not found"}`, recorder.Body.String())
+		})
+	}
+}
+
+func TestRespond_WritesNoBodyOnNilResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	engine := newTestEngine(WithRegistry(NewErrorRegistry()), func(c *gin.Context) {
+		Respond(c, assert.AnError)
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestAbortWithErrorFrom_RendersRenderResponses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, render.Data{ContentType: "text/csv", Data: []byte("field,error\namount,invalid\n")}
+	})
+
+	tests := map[string]gin.HandlerFunc{
+		"abort": func(c *gin.Context) {
+			AbortWithErrorFrom(c, registry, &AError{})
+		},
+		"respond": func(c *gin.Context) {
+			RespondFrom(c, registry, &AError{})
+		},
+	}
+
+	for name, handler := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			engine := newTestEngine(handler)
+
+			// Act
+			recorder := serveTestRequest(engine)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.Equal(t, "text/csv", recorder.Header().Get("Content-Type"))
+			assert.Equal(t, "field,error\namount,invalid\n", recorder.Body.String())
+		})
+	}
+}
+
+func TestAbortWithErrorFrom_SetsHandlerHeaders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerWithHeadersOn(registry, &AError{}, func(context.Context, *AError) (int, any, http.Header) {
+		return http.StatusServiceUnavailable, "maintenance", RetryAfterHeader(90 * time.Second)
+	})
+
+	tests := map[string]gin.HandlerFunc{
+		"abort": func(c *gin.Context) {
+			AbortWithErrorFrom(c, registry, &AError{})
+		},
+		"respond": func(c *gin.Context) {
+			RespondFrom(c, registry, &AError{})
+		},
+	}
+
+	for name, handler := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			engine := newTestEngine(func(c *gin.Context) {
+				c.Header("Retry-After", "1")
+			}, handler)
+
+			// Act
+			recorder := serveTestRequest(engine)
+
+			// Assert
+			assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			assert.Equal(t, []string{"90"}, recorder.Header().Values("Retry-After"))
+		})
+	}
+}
+
+//nolint:paralleltest // Can't be used, we change the default logger
+func TestAbortWithErrorFrom_SkipsWrittenResponses(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buffer, nil)))
+
+	defer slog.SetDefault(defaultLogger)
+
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "bad request"
+	})
+
+	engine := newTestEngine(func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+
+		AbortWithErrorFrom(c, registry, &AError{})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "ok", recorder.Body.String())
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 1)
+
+	assert.Equal(t, "ginerr: response was already written, skipping error response", lines[0]["msg"])
+	assert.InDelta(t, http.StatusBadRequest, lines[0]["status"], 0)
+	assert.InDelta(t, http.StatusOK, lines[0]["writtenStatus"], 0)
+}
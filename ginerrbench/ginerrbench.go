@@ -0,0 +1,157 @@
+// Package ginerrbench measures how long it takes a registry to resolve errors, so services can benchmark their own
+// registry and samples of the errors they return.
+//
+// Matching visits every registration for every error in the chain of an error, so the resolution latency grows
+// with the amount of handlers and the depth of the error chains. If it becomes significant compared to the latency
+// of your endpoints, reduce the amount of string error registrations (for example by grouping them under a single
+// error type registered with RegisterTypeOn) or split the registry per route group with WithRegistry.
+//
+// Every sample is measured with each of the Strategies, as the matching strategy changes how much of the tree is
+// searched: PreferInnermost and the join strategies always search the entire tree, and JoinWorstStatus and
+// JoinAggregate call every handler that matches. Compare the results of your strategy to the others before changing it.
+package ginerrbench
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ing-bank/ginerr/v3"
+)
+
+// Strategy is a matching strategy of a registry, see ginerr.ErrorRegistry.SetJoinStrategy and
+// ginerr.ErrorRegistry.SetMatchPreference.
+type Strategy struct {
+	// Name describes the strategy in the results
+	Name string
+
+	// Join is the join strategy of the registry
+	Join ginerr.JoinStrategy
+
+	// Preference is the match preference of the registry
+	Preference ginerr.MatchPreference
+}
+
+// Strategies are the matching strategies every sample is measured with.
+var Strategies = []Strategy{
+	{Name: "first-outermost", Join: ginerr.JoinFirstMatch, Preference: ginerr.PreferOutermost},
+	{Name: "first-innermost", Join: ginerr.JoinFirstMatch, Preference: ginerr.PreferInnermost},
+	{Name: "worst-status", Join: ginerr.JoinWorstStatus},
+	{Name: "aggregate", Join: ginerr.JoinAggregate},
+	{Name: "highest-priority", Join: ginerr.JoinHighestPriority},
+}
+
+// Result is the measurement of resolving a single sample error with a single strategy.
+type Result struct {
+	// Strategy is the name of the matching strategy the sample was resolved with
+	Strategy string
+
+	// Name is the name of the sample
+	Name string
+
+	// NsPerOp is the average amount of nanoseconds it took to resolve the error
+	NsPerOp int64
+
+	// AllocsPerOp is the average amount of allocations per resolution
+	AllocsPerOp int64
+
+	// BytesPerOp is the average amount of allocated bytes per resolution
+	BytesPerOp int64
+}
+
+// Report contains the results of all samples for every strategy, in the order of Strategies and sorted by name.
+type Report []Result
+
+// String formats the report as a table with a line per strategy and sample.
+func (r Report) String() string {
+	var builder strings.Builder
+
+	for _, result := range r {
+		fmt.Fprintf(&builder, "%s/%s\t%d ns/op\t%d B/op\t%d allocs/op\n", result.Strategy, result.Name, result.NsPerOp, result.BytesPerOp, result.AllocsPerOp)
+	}
+
+	return builder.String()
+}
+
+// Benchmark runs a sub-benchmark for every strategy and sample, resolving the sample with a clone of the registry
+// that uses the strategy. Call it from a benchmark in your own tests to use the usual `go test -bench` tooling:
+//
+//	func BenchmarkErrors(b *testing.B) {
+//		ginerrbench.Benchmark(b, registry, map[string]error{"not found": ErrNotFound})
+//	}
+func Benchmark(b *testing.B, registry *ginerr.ErrorRegistry, samples map[string]error) {
+	b.Helper()
+
+	for _, strategy := range Strategies {
+		strategyRegistry := withStrategy(registry, strategy)
+
+		b.Run(strategy.Name, func(b *testing.B) {
+			for _, name := range sortedNames(samples) {
+				b.Run(name, resolveFunc(strategyRegistry, samples[name]))
+			}
+		})
+	}
+}
+
+// Run benchmarks every sample for every strategy with testing.Benchmark and returns the results, for use outside of
+// `go test`, like from a command that prints the report. Every measurement takes about as long as the
+// -test.benchtime flag, which defaults to a second.
+func Run(registry *ginerr.ErrorRegistry, samples map[string]error) Report {
+	report := make(Report, 0, len(Strategies)*len(samples))
+
+	for _, strategy := range Strategies {
+		strategyRegistry := withStrategy(registry, strategy)
+
+		for _, name := range sortedNames(samples) {
+			result := testing.Benchmark(resolveFunc(strategyRegistry, samples[name]))
+
+			report = append(report, Result{
+				Strategy:    strategy.Name,
+				Name:        name,
+				NsPerOp:     result.NsPerOp(),
+				AllocsPerOp: result.AllocsPerOp(),
+				BytesPerOp:  result.AllocedBytesPerOp(),
+			})
+		}
+	}
+
+	return report
+}
+
+// withStrategy returns a clone of the registry that uses the strategy, so the registry itself isn't modified.
+func withStrategy(registry *ginerr.ErrorRegistry, strategy Strategy) *ginerr.ErrorRegistry {
+	clone := registry.Clone()
+	clone.SetJoinStrategy(strategy.Join)
+	clone.SetMatchPreference(strategy.Preference)
+
+	return clone
+}
+
+// resolveFunc returns a benchmark function that resolves err with the registry.
+func resolveFunc(registry *ginerr.ErrorRegistry, err error) func(b *testing.B) {
+	return func(b *testing.B) {
+		ctx := context.Background()
+
+		b.ReportAllocs()
+		b.ResetTimer()
+
+		for range b.N {
+			_, _ = ginerr.NewErrorResponseFrom(ctx, registry, err)
+		}
+	}
+}
+
+// sortedNames returns the names of the samples in a stable order.
+func sortedNames(samples map[string]error) []string {
+	names := make([]string, 0, len(samples))
+
+	for name := range samples {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
@@ -0,0 +1,73 @@
+package ginerrbench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/ing-bank/ginerr/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errNotFound = errors.New("not found")
+
+// newTestRegistry returns a registry with a handler for errNotFound
+func newTestRegistry() *ginerr.ErrorRegistry {
+	registry := ginerr.NewErrorRegistry()
+
+	ginerr.RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	return registry
+}
+
+func TestRun_ReturnsResultPerStrategyAndSample(t *testing.T) {
+	if testing.Short() {
+		t.Skip("benchmarks take a second per strategy and sample")
+	}
+
+	t.Parallel()
+	// Arrange
+	samples := map[string]error{
+		"wrapped": fmt.Errorf("loading order: %w", errNotFound),
+	}
+
+	// Act
+	result := Run(newTestRegistry(), samples)
+
+	// Assert
+	require.Len(t, result, len(Strategies))
+
+	for i, strategy := range Strategies {
+		assert.Equal(t, strategy.Name, result[i].Strategy)
+		assert.Equal(t, "wrapped", result[i].Name)
+		assert.Positive(t, result[i].NsPerOp)
+	}
+}
+
+func TestReport_String_ReturnsTable(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	report := Report{
+		{Strategy: "first-outermost", Name: "a", NsPerOp: 120, AllocsPerOp: 2, BytesPerOp: 64},
+		{Strategy: "aggregate", Name: "b", NsPerOp: 80, AllocsPerOp: 1, BytesPerOp: 32},
+	}
+
+	// Act
+	result := report.String()
+
+	// Assert
+	assert.Equal(t, "first-outermost/a\t120 ns/op\t64 B/op\t2 allocs/op\naggregate/b\t80 ns/op\t32 B/op\t1 allocs/op\n", result)
+}
+
+func BenchmarkBenchmark(b *testing.B) {
+	Benchmark(b, newTestRegistry(), map[string]error{
+		"direct":  errNotFound,
+		"wrapped": fmt.Errorf("loading order: %w", errNotFound),
+		"default": errors.New("unknown"),
+	})
+}
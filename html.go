@@ -0,0 +1,76 @@
+package ginerr
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// htmlFormats are the formats RespondHTML can write, HTML comes last so it's only used if the client prefers it
+var htmlFormats = []string{binding.MIMEJSON, binding.MIMEXML, binding.MIMEYAML, binding.MIMEHTML}
+
+// ErrorPage is the data passed to the HTML templates of RespondHTML.
+type ErrorPage struct {
+	// Status is the status code of the response
+	Status int
+
+	// StatusText is the text of the status code, like "Not Found"
+	StatusText string
+
+	// Response is the response returned by the handler, which might be nil
+	Response any
+}
+
+// HTMLTemplateFunc returns the name of the HTML template used for an error, an empty name falls back to the
+// formats of Respond.
+type HTMLTemplateFunc func(status int, err error) string
+
+// HTMLTemplatesByStatus returns an HTMLTemplateFunc that picks the template by status code, statuses without a
+// template use the fallback. An empty fallback falls back to the formats of Respond.
+func HTMLTemplatesByStatus(templates map[int]string, fallback string) HTMLTemplateFunc {
+	return func(status int, _ error) string {
+		if name, ok := templates[status]; ok {
+			return name
+		}
+
+		return fallback
+	}
+}
+
+// RespondHTML is like RespondHTMLFrom, but using the registry attached to the request (see WithRegistry) or the
+// DefaultErrorRegistry.
+func RespondHTML(c *gin.Context, err error, templates HTMLTemplateFunc) {
+	RespondHTMLFrom(c, registryFromContext(c), err, templates)
+}
+
+// RespondHTMLFrom resolves the error using the given registry like RespondFrom, but renders an HTML error page
+// with gin's HTML templates (see gin.Engine.LoadHTMLGlob) if the client prefers HTML and templates returns a template
+// for the error. The template receives an ErrorPage. JSON is used if the client has no preference or if there is
+// no template, so the same handlers can serve browsers and API clients.
+func RespondHTMLFrom(c *gin.Context, registry *ErrorRegistry, err error, templates HTMLTemplateFunc) {
+	response := newGinErrorResponse(c, registry, err)
+	code := response.Code
+
+	name := templates(code, err)
+	format := c.NegotiateFormat(htmlFormats...)
+
+	if format != binding.MIMEHTML || name == "" {
+		write := negotiateWriter(c, registry.getJSONEncoder())
+
+		// Clients that only accept HTML get JSON if there is no template
+		if format == binding.MIMEHTML {
+			write = jsonWriter(c, registry.getJSONEncoder())
+		}
+
+		abortWithResponse(c, code, response.Body, write)
+
+		return
+	}
+
+	page := ErrorPage{Status: code, StatusText: http.StatusText(code), Response: response.Body}
+
+	abortWithResponse(c, code, page, func(code int, page any) {
+		c.HTML(code, name, page)
+	})
+}
@@ -0,0 +1,110 @@
+package ginerr
+
+import (
+	"context"
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespondHTMLFrom_RendersTemplateOrFallsBack(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusNotFound, "order not found"
+	})
+
+	templates := template.Must(template.New("404.html").Parse(`<h1>{{ .Status }} {{ .StatusText }}</h1><p>{{ .Response }}</p>`))
+	template.Must(templates.New("error.html").Parse(`<h1>{{ .Status }}</h1>`))
+
+	tests := map[string]struct {
+		err                 error
+		accept              string
+		expectedCode        int
+		expectedContentType string
+		expectedBody        string
+	}{
+		"html by status": {
+			err:                 &AError{},
+			accept:              "text/html,application/xhtml+xml,*/*;q=0.8",
+			expectedCode:        http.StatusNotFound,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        `<h1>404 Not Found</h1><p>order not found</p>`,
+		},
+		"html fallback": {
+			err:                 &BError{},
+			accept:              "text/html",
+			expectedCode:        http.StatusInternalServerError,
+			expectedContentType: "text/html; charset=utf-8",
+			expectedBody:        `<h1>500</h1>`,
+		},
+		"json preferred": {
+			err:                 &AError{},
+			accept:              "application/json",
+			expectedCode:        http.StatusNotFound,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedBody:        `"order not found"`,
+		},
+		"no preference": {
+			err:                 &AError{},
+			expectedCode:        http.StatusNotFound,
+			expectedContentType: "application/json; charset=utf-8",
+			expectedBody:        `"order not found"`,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			engine := newTestEngine(func(c *gin.Context) {
+				RespondHTMLFrom(c, registry, testData.err, HTMLTemplatesByStatus(map[int]string{http.StatusNotFound: "404.html"}, "error.html"))
+			})
+			engine.SetHTMLTemplate(templates)
+
+			recorder := httptest.NewRecorder()
+			request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			request.Header.Set("Accept", testData.accept)
+
+			// Act
+			engine.ServeHTTP(recorder, request)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, recorder.Code)
+			assert.Equal(t, testData.expectedContentType, recorder.Header().Get("Content-Type"))
+			assert.Equal(t, testData.expectedBody, recorder.Body.String())
+		})
+	}
+}
+
+func TestRespondHTML_FallsBackOnNoTemplate(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, "conflict"
+	})
+
+	engine := newTestEngine(WithRegistry(registry), func(c *gin.Context) {
+		RespondHTML(c, &AError{}, HTMLTemplatesByStatus(nil, ""))
+	})
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	request.Header.Set("Accept", "text/html")
+
+	// Act
+	engine.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `"conflict"`, recorder.Body.String())
+}
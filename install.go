@@ -0,0 +1,22 @@
+package ginerr
+
+// Installer is implemented by libraries that ship error handlers for their own errors, so applications can install
+// them explicitly in one place instead of relying on init functions that register on DefaultErrorRegistry.
+type Installer interface {
+	RegisterGinerrHandlers(registry *ErrorRegistry)
+}
+
+// InstallerFunc allows ordinary functions to be used as an Installer.
+type InstallerFunc func(registry *ErrorRegistry)
+
+func (f InstallerFunc) RegisterGinerrHandlers(registry *ErrorRegistry) {
+	f(registry)
+}
+
+// Install registers the handlers of the modules in the registry in the given order, so a later module replaces
+// handlers an earlier module registered for the same instance or type.
+func (e *ErrorRegistry) Install(modules ...Installer) {
+	for _, module := range modules {
+		module.RegisterGinerrHandlers(e)
+	}
+}
@@ -0,0 +1,54 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type accountsModule struct {
+	code int
+}
+
+func (a accountsModule) RegisterGinerrHandlers(registry *ErrorRegistry) {
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return a.code, nil
+	})
+}
+
+func TestErrorRegistry_Install_RegistersModules(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	paymentsModule := InstallerFunc(func(registry *ErrorRegistry) {
+		RegisterErrorHandlerOn(registry, &BError{}, func(context.Context, *BError) (int, any) {
+			return http.StatusPaymentRequired, nil
+		})
+	})
+
+	// Act
+	registry.Install(accountsModule{code: http.StatusNotFound}, paymentsModule)
+
+	// Assert
+	aCode, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	bCode, _ := NewErrorResponseFrom(context.Background(), registry, &BError{})
+
+	assert.Equal(t, http.StatusNotFound, aCode)
+	assert.Equal(t, http.StatusPaymentRequired, bCode)
+}
+
+func TestErrorRegistry_Install_LaterModulesOverride(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	registry.Install(accountsModule{code: http.StatusNotFound}, accountsModule{code: http.StatusGone})
+
+	// Assert
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	assert.Equal(t, http.StatusGone, code)
+}
@@ -0,0 +1,29 @@
+package ginerr
+
+import (
+	"context"
+	"reflect"
+)
+
+// RegisterInterface registers an error handler for errors implementing the interface I in DefaultErrorRegistry.
+func RegisterInterface[I any](handler func(context.Context, I) (int, any), options ...RegistrationOption) {
+	RegisterInterfaceOn(DefaultErrorRegistry, handler, options...)
+}
+
+// RegisterInterfaceOn registers an error handler in the given registry for errors implementing the interface I,
+// which doesn't have to include the Error method, like `interface{ Temporary() bool }` or `interface{ Code() string }`.
+// Many libraries expose behavior interfaces rather than concrete errors. The first error in the tree that implements
+// I is passed to the handler. It panics if I is not an interface or has no methods, as it would match every error.
+func RegisterInterfaceOn[I any](registry *ErrorRegistry, handler func(context.Context, I) (int, any), options ...RegistrationOption) {
+	interfaceType := reflect.TypeFor[I]()
+
+	if interfaceType.Kind() != reflect.Interface {
+		panic("ginerr: RegisterInterfaceOn requires an interface, use RegisterTypeOn for " + interfaceType.String())
+	}
+
+	if interfaceType.NumMethod() == 0 {
+		panic("ginerr: can't register a handler for an interface without methods, it would match every error, use RegisterDefaultHandler instead")
+	}
+
+	registry.addHandler(typeKey{interfaceType}, newErrorHandler(false, withoutHeaders(handler), options))
+}
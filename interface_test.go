@@ -0,0 +1,88 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterInterfaceOn_MatchesErrorsImplementingInterface(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterInterfaceOn(registry, func(_ context.Context, err interface{ Code() string }) (int, any) {
+		return http.StatusBadRequest, err.Code()
+	})
+
+	RegisterInterfaceOn(registry, func(context.Context, interface{ Temporary() bool }) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"code": {
+			err:              fmt.Errorf("wrapped: %w", &codedError{code: "INVALID_AMOUNT"}),
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "INVALID_AMOUNT",
+		},
+		"temporary": {
+			err:          fmt.Errorf("wrapped: %w", dummyTemporaryError{}),
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		"neither": {
+			err:          &AError{},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterInterfaceOn_PanicsOnNonInterface(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterInterfaceOn(registry, func(context.Context, *AError) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: RegisterInterfaceOn requires an interface, use RegisterTypeOn for *ginerr.AError", result)
+}
+
+func TestRegisterInterfaceOn_PanicsOnEmptyInterface(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterInterfaceOn(registry, func(context.Context, any) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for an interface without methods, it would match every error, use RegisterDefaultHandler instead", result)
+}
@@ -0,0 +1,101 @@
+package ginerr
+
+import (
+	"context"
+	"iter"
+	"reflect"
+	"time"
+)
+
+// Handler is a registered error handler, it accepts any error that matches its registration.
+type Handler func(ctx context.Context, err error) (int, any)
+
+// HandlerInfo describes a registration in the registry.
+type HandlerInfo struct {
+	// Type is the error type the handler was registered for
+	Type reflect.Type
+
+	// Error is the instance the handler was registered with, if any. For string errors this is
+	// the sentinel error that is matched.
+	Error error
+
+	// IsStringError is true if the handler matches a specific error created by errors.New or fmt.Errorf
+	IsStringError bool
+
+	// CacheMaxAge is how long clients may cache the response, zero if it's not cacheable, see Cacheable
+	CacheMaxAge time.Duration
+
+	// Priority is the priority of the registration, see WithPriority
+	Priority int
+
+	// Fallback is true if the handler is only used if no other handler matches, see RegisterPackageHandlerOn
+	Fallback bool
+}
+
+// All returns an iterator over all registered handlers in the order they were first registered, the default
+// handler is not included. Registrations with a nil handler (see Validate) and registrations that only exist for
+// their API version variants (see ForAPIVersion) are skipped, as they can't be called. The registrations are copied
+// before iterating, so handlers can be registered from within the loop, but they aren't part of the iteration.
+func (e *ErrorRegistry) All() iter.Seq2[HandlerInfo, Handler] {
+	return func(yield func(HandlerInfo, Handler) bool) {
+		for _, entry := range e.entries() {
+			if !yield(entry.info, entry.handler.withoutHeaders) {
+				return
+			}
+		}
+	}
+}
+
+// registryEntry is a registration that can be called, see All.
+type registryEntry struct {
+	info    HandlerInfo
+	handler *errorHandler
+}
+
+// entries returns the registrations that can be called, in the order they were first registered.
+func (e *ErrorRegistry) entries() []registryEntry {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	entries := make([]registryEntry, 0, len(e.order))
+
+	for _, errConcrete := range e.order {
+		handler := e.handlers[errConcrete]
+		if handler.isNil || handler.placeholder {
+			continue
+		}
+
+		entries = append(entries, registryEntry{info: handlerInfo(errConcrete, handler), handler: handler})
+	}
+
+	return entries
+}
+
+// handlerInfo describes the handler that was registered under the given key.
+func handlerInfo(errConcrete error, handler *errorHandler) HandlerInfo {
+	info := HandlerInfo{
+		Type:          handler.errorType,
+		IsStringError: handler.isStringError,
+		CacheMaxAge:   handler.cacheMaxAge,
+		Priority:      handler.priority,
+		Fallback:      handler.fallback,
+	}
+
+	// Registrations without an instance are stored under their type or predicate
+	switch errConcrete.(type) {
+	case typeKey, matcherKey, codeKey, typeNameKey, packageKey, behaviorKey:
+	case equalityKey:
+		info.Error = handler.instance
+	default:
+		info.Error = errConcrete
+	}
+
+	return info
+}
+
+// withoutHeaders calls the handler and drops the headers it returned, so it can be used as a Handler.
+func (h *errorHandler) withoutHeaders(ctx context.Context, err error) (int, any) {
+	code, response, _ := h.handle(ctx, err)
+
+	return code, response
+}
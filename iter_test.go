@@ -0,0 +1,121 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll_ReturnsAllRegistrations(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	instance := &AError{}
+	sentinel := errors.New("sentinel")
+
+	RegisterErrorHandlerOn(registry, instance, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "a"
+	})
+	RegisterErrorHandlerOn(registry, sentinel, func(context.Context, error) (int, any) {
+		return http.StatusConflict, "sentinel"
+	})
+	RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+		return http.StatusNotFound, "b"
+	})
+
+	// Act
+	result := map[reflect.Type]HandlerInfo{}
+	codes := map[reflect.Type]int{}
+	for info, handler := range registry.All() {
+		result[info.Type] = info
+		codes[info.Type], _ = handler(context.Background(), info.Error)
+	}
+
+	// Assert
+	assert.Len(t, result, 3)
+
+	aType := reflect.TypeFor[*AError]()
+	assert.Equal(t, HandlerInfo{Type: aType, Error: instance}, result[aType])
+	assert.Equal(t, http.StatusBadRequest, codes[aType])
+
+	errType := reflect.TypeFor[error]()
+	assert.Equal(t, HandlerInfo{Type: errType, Error: sentinel, IsStringError: true}, result[errType])
+	assert.Equal(t, http.StatusConflict, codes[errType])
+
+	bType := reflect.TypeFor[*BError]()
+	assert.Equal(t, HandlerInfo{Type: bType}, result[bType])
+}
+
+func TestAll_StopsWhenRequested(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	count := 0
+	for range registry.All() {
+		count++
+		break
+	}
+
+	// Assert
+	assert.Equal(t, 1, count)
+}
+
+func TestAll_SkipsRegistrationsThatCantBeCalled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn[*AError](registry, nil)
+	RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+		return http.StatusGone, nil
+	}, ForAPIVersion("v2"))
+	RegisterTypeOn(registry, func(context.Context, temporaryError) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	// Act
+	var result []reflect.Type
+	for info := range registry.All() {
+		result = append(result, info.Type)
+	}
+
+	// Assert
+	assert.Equal(t, []reflect.Type{reflect.TypeFor[temporaryError]()}, result)
+}
+
+func TestAll_AllowsRegistrationFromLoop(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	count := 0
+	for range registry.All() {
+		count++
+
+		RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+	}
+
+	// Assert
+	assert.Equal(t, 1, count)
+	assert.Len(t, registry.Rules(), 2)
+}
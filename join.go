@@ -0,0 +1,100 @@
+package ginerr
+
+import (
+	"cmp"
+	"context"
+	"net/http"
+	"slices"
+)
+
+// JoinStrategy decides which handler is used if multiple handlers match an error tree, for example when errors.Join
+// combines errors that have different handlers, or when a registered error wraps another registered error.
+type JoinStrategy int
+
+const (
+	// JoinFirstMatch uses the handler of the first error in the tree that has one, searching depth-first like
+	// errors.As. It's the default.
+	JoinFirstMatch JoinStrategy = iota
+
+	// JoinWorstStatus calls every handler that matches an error in the tree and uses the response with the highest
+	// status code, the first one wins if multiple responses have the same status code. Keep in mind that all
+	// matching handlers are called.
+	JoinWorstStatus
+
+	// JoinAggregate calls every handler that matches an error in the tree. If more than one matches, the responses
+	// are combined into an AggregateResponse with the highest status code, the headers of that response are used.
+	JoinAggregate
+
+	// JoinHighestPriority uses the handler with the highest priority (see WithPriority) that matches an error in the
+	// tree, the first one in the depth-first order of errors.As wins if multiple handlers have the same priority.
+	// Unlike the other join strategies, only the handler that is used is called, unless it declines with ErrSkip.
+	JoinHighestPriority
+)
+
+// AggregateResponse is the response of JoinAggregate if multiple handlers matched.
+type AggregateResponse struct {
+	// Errors are the responses of the matched handlers, in the order the errors were found in the tree
+	Errors []any `json:"errors"`
+}
+
+// SetJoinStrategy sets the strategy that decides which handler is used if multiple handlers match an error tree.
+func (e *ErrorRegistry) SetJoinStrategy(strategy JoinStrategy) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.joinStrategy = strategy
+}
+
+// resolveAll calls every matching handler and returns the response with the highest status code. If aggregate is
+// true and multiple handlers matched, their responses are combined into an AggregateResponse.
+func (e *ErrorRegistry) resolveAll(ctx context.Context, err error, aggregate bool) (int, any, http.Header, handlerMatch, bool) {
+	var (
+		code     int
+		response any
+		headers  http.Header
+		worst    handlerMatch
+	)
+
+	matches := e.matches(ctx, err)
+	responses := make([]any, 0, len(matches))
+
+	for _, match := range matches {
+		matchCode, matchResponse, matchHeaders := match.call(ctx)
+		if isSkip(matchResponse) {
+			continue
+		}
+
+		responses = append(responses, matchResponse)
+
+		if matchCode > code {
+			code, response, headers, worst = matchCode, matchResponse, matchHeaders, match
+		}
+	}
+
+	if aggregate && len(responses) > 1 {
+		response = &AggregateResponse{Errors: responses}
+	}
+
+	return code, response, headers, worst, len(responses) > 0
+}
+
+// resolveHighestPriority calls the matching handler with the highest priority, see JoinHighestPriority.
+func (e *ErrorRegistry) resolveHighestPriority(ctx context.Context, err error) (int, any, http.Header, handlerMatch, bool) {
+	matches := e.matches(ctx, err)
+
+	// The sort is stable, so the first match in the tree wins if the priorities are the same
+	slices.SortStableFunc(matches, func(a, b handlerMatch) int {
+		return cmp.Compare(b.handler.priority, a.handler.priority)
+	})
+
+	for _, match := range matches {
+		code, response, headers := match.call(ctx)
+		if isSkip(response) {
+			continue
+		}
+
+		return code, response, headers, match, true
+	}
+
+	return 0, nil, nil, handlerMatch{}, false
+}
@@ -0,0 +1,225 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// wrappingError is a registered error that wraps another error
+type wrappingError struct {
+	err error
+}
+
+func (e *wrappingError) Error() string {
+	return "wrapping: " + e.err.Error()
+}
+
+func (e *wrappingError) Unwrap() error {
+	return e.err
+}
+
+// newJoinTestRegistry returns a registry with handlers for AError (400) and BError (503)
+func newJoinTestRegistry() *ErrorRegistry {
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, "a: " + err.message
+	})
+
+	RegisterErrorHandlerOn(registry, &BError{}, func(_ context.Context, err *BError) (int, any) {
+		return http.StatusServiceUnavailable, "b: " + err.message
+	})
+
+	return registry
+}
+
+func TestNewErrorResponseFrom_MatchesFirstErrorInJoinedErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newJoinTestRegistry()
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"a first": {
+			err:              errors.Join(errors.New("unknown"), &AError{message: "1"}, &BError{message: "2"}),
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "a: 1",
+		},
+		"b first": {
+			err:              fmt.Errorf("wrapped: %w", errors.Join(&BError{message: "1"}, &AError{message: "2"})),
+			expectedCode:     http.StatusServiceUnavailable,
+			expectedResponse: "b: 1",
+		},
+		"second a": {
+			err:              errors.Join(errors.New("unknown"), fmt.Errorf("%w", &AError{message: "2"})),
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "a: 2",
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestNewErrorResponseFrom_MatchesOutermostRegisteredError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	RegisterErrorHandlerOn(registry, &wrappingError{}, func(context.Context, *wrappingError) (int, any) {
+		return http.StatusConflict, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &wrappingError{err: errNotFound})
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, code)
+}
+
+func TestNewErrorResponseFrom_MatchesFirstRegisteredHandlerOfSameError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, temporaryError) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	RegisterErrorHandlerOn(registry, dummyTemporaryError{}, func(context.Context, dummyTemporaryError) (int, any) {
+		return http.StatusTooManyRequests, nil
+	})
+
+	// Act
+	for range 20 {
+		code, _ := NewErrorResponseFrom(context.Background(), registry, dummyTemporaryError{})
+
+		// Assert
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+	}
+}
+
+func TestErrorRegistry_SetJoinStrategy_WorstStatus(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newJoinTestRegistry()
+	registry.SetJoinStrategy(JoinWorstStatus)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, errors.Join(&AError{message: "1"}, &BError{message: "2"}))
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "b: 2", response)
+}
+
+func TestErrorRegistry_SetJoinStrategy_WorstStatusFallsBackToDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newJoinTestRegistry()
+	registry.SetJoinStrategy(JoinWorstStatus)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, errors.Join(errors.New("unknown")))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+}
+
+func TestErrorRegistry_SetJoinStrategy_Aggregate(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newJoinTestRegistry()
+	registry.SetJoinStrategy(JoinAggregate)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, errors.Join(&AError{message: "1"}, errors.New("unknown"), &BError{message: "2"}))
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, &AggregateResponse{Errors: []any{"a: 1", "b: 2"}}, response)
+}
+
+func TestErrorRegistry_SetJoinStrategy_AggregateSingleMatch(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newJoinTestRegistry()
+	registry.SetJoinStrategy(JoinAggregate)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, errors.Join(&AError{message: "1"}, errors.New("unknown")))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "a: 1", response)
+}
+
+func TestErrorRegistry_SetJoinStrategy_HighestPriority(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetJoinStrategy(JoinHighestPriority)
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "low"
+	})
+	RegisterErrorHandlerOn(registry, &BError{}, func(context.Context, *BError) (int, any) {
+		return http.StatusConflict, "high"
+	}, WithPriority(10))
+
+	tests := map[string]error{
+		"joined":  errors.Join(&AError{}, &BError{}),
+		"wrapped": &wrappingError{err: fmt.Errorf("b: %w", &BError{})},
+		"nested":  errors.Join(&AError{}, fmt.Errorf("b: %w", &BError{})),
+	}
+
+	for name, err := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, err)
+
+			// Assert
+			assert.Equal(t, http.StatusConflict, code)
+			assert.Equal(t, "high", response)
+		})
+	}
+}
+
+func TestErrorRegistry_SetJoinStrategy_HighestPriorityUsesFirstMatchOnSamePriority(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newJoinTestRegistry()
+	registry.SetJoinStrategy(JoinHighestPriority)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, errors.Join(&BError{message: "1"}, &AError{message: "2"}))
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, "b: 1", response)
+}
@@ -0,0 +1,24 @@
+package ginerr
+
+// SetLegacyResolver sets a resolver that is consulted for errors that no handler matched, before the default
+// handler is called. It's meant for teams migrating from another error-mapping library, who can keep its
+// behavior as a safety net during the migration. The resolver returns false if it didn't recognise the error.
+func (e *ErrorRegistry) SetLegacyResolver(resolver func(err error) (int, any, bool)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.legacyResolver = resolver
+}
+
+// resolveLegacy calls the legacy resolver if one was set, the boolean is false if it didn't resolve the error.
+func (e *ErrorRegistry) resolveLegacy(err error) (int, any, bool) {
+	e.mu.RLock()
+	resolver := e.legacyResolver
+	e.mu.RUnlock()
+
+	if resolver == nil {
+		return 0, nil, false
+	}
+
+	return resolver(err)
+}
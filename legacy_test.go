@@ -0,0 +1,72 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetLegacyResolver_IsUsedBeforeDefaultHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithErr error
+	registry.SetLegacyResolver(func(err error) (int, any, bool) {
+		calledWithErr = err
+		return http.StatusTeapot, "legacy", true
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, assert.AnError)
+
+	// Assert
+	assert.Equal(t, http.StatusTeapot, code)
+	assert.Equal(t, "legacy", response)
+	assert.Equal(t, assert.AnError, calledWithErr)
+}
+
+func TestSetLegacyResolver_FallsBackToDefaultHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	registry.SetLegacyResolver(func(error) (int, any, bool) {
+		return http.StatusTeapot, "legacy", false
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, assert.AnError)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+}
+
+func TestSetLegacyResolver_IsNotUsedForRegisteredErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	sentinel := errors.New("sentinel")
+
+	RegisterErrorHandlerOn(registry, sentinel, func(context.Context, error) (int, any) {
+		return http.StatusConflict, "registered"
+	})
+
+	var called bool
+	registry.SetLegacyResolver(func(error) (int, any, bool) {
+		called = true
+		return http.StatusTeapot, "legacy", true
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, sentinel)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, code)
+	assert.Equal(t, "registered", response)
+	assert.False(t, called)
+}
@@ -0,0 +1,60 @@
+package ginerr
+
+// Stats contains the amount of registrations in a registry.
+type Stats struct {
+	// Handlers is the amount of registered handlers, including those for string errors
+	Handlers int
+
+	// StringErrors is the amount of handlers registered for string errors, created by errors.New or fmt.Errorf
+	StringErrors int
+
+	// RemoteErrors is the amount of registered remote error codes
+	RemoteErrors int
+}
+
+// SoftLimits are thresholds on the size of a registry, a zero value disables a limit.
+type SoftLimits struct {
+	// Handlers is the maximum expected amount of handlers
+	Handlers int
+
+	// StringErrors is the maximum expected amount of handlers for string errors
+	StringErrors int
+}
+
+// crossed returns true if one of the limits was exceeded after, but not before.
+func (s SoftLimits) crossed(before Stats, after Stats) bool {
+	exceeds := func(limit int, count int) bool {
+		return limit > 0 && count > limit
+	}
+
+	return (!exceeds(s.Handlers, before.Handlers) && exceeds(s.Handlers, after.Handlers)) ||
+		(!exceeds(s.StringErrors, before.StringErrors) && exceeds(s.StringErrors, after.StringErrors))
+}
+
+// RegisterSoftLimits registers a warning that is called once when a registration makes the registry exceed
+// one of the limits, for example to log it. Registrations are never refused, the limits help to detect
+// accidental unbounded registration like registering a string error for every request.
+func (e *ErrorRegistry) RegisterSoftLimits(limits SoftLimits, warn func(stats Stats)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.softLimits = limits
+	e.softLimitWarning = warn
+}
+
+// Stats returns the amount of registrations in the registry.
+func (e *ErrorRegistry) Stats() Stats {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	return e.stats()
+}
+
+// stats returns the amount of registrations, the caller must hold the lock.
+func (e *ErrorRegistry) stats() Stats {
+	return Stats{
+		Handlers:     len(e.handlers),
+		StringErrors: e.stringErrors,
+		RemoteErrors: len(e.remoteErrors),
+	}
+}
@@ -0,0 +1,72 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorRegistry_Stats_ReturnsRegistrationCounts(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+	handler := func(context.Context, error) (int, any) { return http.StatusNotFound, nil }
+
+	RegisterErrorHandlerOn(registry, errNotFound, handler)
+	RegisterErrorHandlerOn(registry, errNotFound, handler)
+	RegisterErrorHandlerOn(registry, errors.New("gone"), handler)
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) { return http.StatusBadRequest, nil })
+	RegisterRemoteErrorOn(registry, "NOT_FOUND", errNotFound)
+
+	// Act
+	result := registry.Stats()
+
+	// Assert
+	assert.Equal(t, Stats{Handlers: 3, StringErrors: 2, RemoteErrors: 1}, result)
+}
+
+func TestErrorRegistry_RegisterSoftLimits_WarnsOnceWhenCrossed(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var warnings []Stats
+
+	registry.RegisterSoftLimits(SoftLimits{StringErrors: 2}, func(stats Stats) {
+		warnings = append(warnings, stats)
+	})
+
+	// Act
+	for i := range 5 {
+		RegisterErrorHandlerOn(registry, fmt.Errorf("request %d failed", i), func(context.Context, error) (int, any) {
+			return http.StatusInternalServerError, nil
+		})
+	}
+
+	// Assert
+	assert.Equal(t, []Stats{{Handlers: 3, StringErrors: 3}}, warnings)
+}
+
+func TestErrorRegistry_RegisterSoftLimits_IgnoresDisabledLimits(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	warned := false
+
+	registry.RegisterSoftLimits(SoftLimits{}, func(Stats) {
+		warned = true
+	})
+
+	// Act
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) { return http.StatusBadRequest, nil })
+
+	// Assert
+	assert.False(t, warned)
+}
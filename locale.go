@@ -0,0 +1,92 @@
+package ginerr
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// localeContextKey is the context key under which the locale is stored
+type localeContextKey struct{}
+
+// WithLocale returns a copy of the context with the given locale (e.g. "nl-NL"), error handlers can retrieve
+// it using LocaleFromContext to pick the language of their response.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFromContext returns the locale set by WithLocale, the boolean is false if no locale was set. For gin
+// contexts, the locale may also be set on the context of the request, like
+// `c.Request = c.Request.WithContext(ginerr.WithLocale(c.Request.Context(), "nl-NL"))`.
+func LocaleFromContext(ctx context.Context) (string, bool) {
+	locale, ok := requestContextValue(ctx, localeContextKey{}).(string)
+
+	return locale, ok
+}
+
+// LocaleSource returns a locale for the context, the boolean is false if it has none. LocaleFromContext
+// is a LocaleSource, others might look up the locale of the user profile or the tenant.
+type LocaleSource func(ctx context.Context) (string, bool)
+
+// LocaleChain returns a LocaleSource that tries the sources in order and returns the first locale that is found,
+// like AcceptLanguageLocale, then the user profile, then the tenant default and finally StaticLocale.
+func LocaleChain(sources ...LocaleSource) LocaleSource {
+	return func(ctx context.Context) (string, bool) {
+		for _, source := range sources {
+			if locale, ok := source(ctx); ok {
+				return locale, true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// StaticLocale returns a LocaleSource that always returns the given locale, used as the last step of a LocaleChain.
+func StaticLocale(locale string) LocaleSource {
+	return func(context.Context) (string, bool) {
+		return locale, true
+	}
+}
+
+// AcceptLanguageLocale returns the preferred language of the Accept-Language header of the gin request the context
+// belongs to, the boolean is false if the context doesn't belong to a gin request or the header is missing.
+func AcceptLanguageLocale(ctx context.Context) (string, bool) {
+	c := ginContextFrom(ctx)
+	if c == nil || c.Request == nil {
+		return "", false
+	}
+
+	return preferredLanguage(c.GetHeader("Accept-Language"))
+}
+
+// preferredLanguage returns the language with the highest quality in an Accept-Language header, the first
+// one wins if multiple languages have the same quality. Wildcards are ignored.
+func preferredLanguage(header string) (string, bool) {
+	var result string
+
+	bestQuality := 0.0
+
+	for _, part := range strings.Split(header, ",") {
+		language, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		quality := 1.0
+
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+
+			quality = parsed
+		}
+
+		if language == "" || language == "*" || quality <= bestQuality {
+			continue
+		}
+
+		result, bestQuality = language, quality
+	}
+
+	return result, result != ""
+}
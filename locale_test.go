@@ -0,0 +1,155 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleFromContext_ReturnsFalseOnNoLocale(t *testing.T) {
+	t.Parallel()
+	// Act
+	locale, ok := LocaleFromContext(context.Background())
+
+	// Assert
+	assert.False(t, ok)
+	assert.Empty(t, locale)
+}
+
+func TestLocaleFromContext_ReturnsLocaleToHandlers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	callback := func(ctx context.Context, _ *AError) (int, any) {
+		if locale, _ := LocaleFromContext(ctx); locale == "nl-NL" {
+			return http.StatusBadRequest, "ongeldige invoer"
+		}
+
+		return http.StatusBadRequest, "invalid input"
+	}
+
+	RegisterErrorHandlerOn(registry, &AError{}, callback)
+
+	ctx := WithLocale(context.Background(), "nl-NL")
+
+	// Act
+	code, response := NewErrorResponseFrom(ctx, registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "ongeldige invoer", response)
+}
+
+func TestLocaleFromContext_ReturnsLocaleOfGinRequest(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(ctx context.Context, _ *AError) (int, any) {
+		locale, _ := LocaleChain(LocaleFromContext, StaticLocale("en-US"))(ctx)
+
+		return http.StatusBadRequest, locale
+	})
+
+	engine := newTestEngine(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(WithLocale(c.Request.Context(), "nl-NL"))
+
+		AbortWithErrorFrom(c, registry, &AError{})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `"nl-NL"`, recorder.Body.String())
+}
+
+func TestLocaleChain_ReturnsFirstLocaleFound(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	userProfile := func(context.Context) (string, bool) { return "", false }
+	tenantDefault := func(context.Context) (string, bool) { return "de-DE", true }
+
+	chain := LocaleChain(LocaleFromContext, userProfile, tenantDefault, StaticLocale("en-US"))
+
+	tests := map[string]struct {
+		ctx      context.Context
+		expected string
+	}{
+		"context": {
+			ctx:      WithLocale(context.Background(), "nl-NL"),
+			expected: "nl-NL",
+		},
+		"tenant default": {
+			ctx:      context.Background(),
+			expected: "de-DE",
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			locale, ok := chain(testData.ctx)
+
+			// Assert
+			assert.True(t, ok)
+			assert.Equal(t, testData.expected, locale)
+		})
+	}
+}
+
+func TestLocaleChain_ReturnsFalseOnNoLocale(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	chain := LocaleChain(LocaleFromContext, AcceptLanguageLocale)
+
+	// Act
+	locale, ok := chain(context.Background())
+
+	// Assert
+	assert.False(t, ok)
+	assert.Empty(t, locale)
+}
+
+func TestAcceptLanguageLocale_ReturnsPreferredLanguage(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		header   string
+		expected string
+		ok       bool
+	}{
+		"single":        {header: "nl-NL", expected: "nl-NL", ok: true},
+		"first wins":    {header: "nl-NL, en-US", expected: "nl-NL", ok: true},
+		"quality":       {header: "en-US;q=0.5, nl-NL;q=0.9, de", expected: "de", ok: true},
+		"lower quality": {header: "en-US;q=0.5, nl-NL;q=0.9", expected: "nl-NL", ok: true},
+		"wildcard":      {header: "*", expected: "", ok: false},
+		"invalid":       {header: "nl-NL;q=abc", expected: "", ok: false},
+		"missing":       {header: "", expected: "", ok: false},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			gin.SetMode(gin.TestMode)
+
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+			c.Request = httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+			c.Request.Header.Set("Accept-Language", testData.header)
+
+			// Act
+			locale, ok := AcceptLanguageLocale(c)
+
+			// Assert
+			assert.Equal(t, testData.ok, ok)
+			assert.Equal(t, testData.expected, locale)
+		})
+	}
+}
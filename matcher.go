@@ -0,0 +1,47 @@
+package ginerr
+
+import (
+	"context"
+	"strconv"
+)
+
+// matcherKey is the key of handlers registered with a predicate, functions can't be used as a map key
+type matcherKey struct {
+	id int
+}
+
+func (m matcherKey) Error() string {
+	return "matcher " + strconv.Itoa(m.id)
+}
+
+// RegisterMatcherHandler registers an error handler for errors matching the predicate in DefaultErrorRegistry.
+func RegisterMatcherHandler(matches func(err error) bool, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterMatcherHandlerOn(DefaultErrorRegistry, matches, handler, options...)
+}
+
+// RegisterMatcherHandlerOn registers an error handler in the given registry for errors matching the predicate, for
+// conditions no type or sentinel is available for, like fields of an error, SQLSTATE codes or substrings. The
+// predicate is called for every error in the tree and the first error it matches is passed to the handler, so it
+// shouldn't unwrap errors itself. It panics if the predicate is nil.
+func RegisterMatcherHandlerOn(registry *ErrorRegistry, matches func(err error) bool, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	if matches == nil {
+		panic("ginerr: can't register a handler for a nil matcher")
+	}
+
+	registry.mu.Lock()
+	registry.matchers++
+	key := matcherKey{id: registry.matchers}
+	registry.mu.Unlock()
+
+	registry.addHandler(key, newMatcherHandler(matches, handler, options))
+}
+
+// newMatcherHandler creates an errorHandler that matches errors using a predicate.
+func newMatcherHandler(matches func(err error) bool, handler func(context.Context, error) (int, any), options []RegistrationOption) *errorHandler {
+	errorHandler := newErrorHandler(false, withoutHeaders(handler), options)
+	errorHandler.isMatcher = true
+	errorHandler.isType = matches
+	errorHandler.isExactType = matches
+
+	return errorHandler
+}
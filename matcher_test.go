@@ -0,0 +1,124 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterMatcherHandlerOn_MatchesPredicate(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterMatcherHandlerOn(registry, func(err error) bool {
+		return strings.Contains(err.Error(), "duplicate key")
+	}, func(_ context.Context, err error) (int, any) {
+		return http.StatusConflict, err.Error()
+	})
+
+	RegisterMatcherHandlerOn(registry, func(err error) bool {
+		var aErr *AError
+
+		return errors.As(err, &aErr) && aErr.message == "special"
+	}, func(context.Context, error) (int, any) {
+		return http.StatusTeapot, nil
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"substring": {
+			err:              fmt.Errorf("inserting order: %w", errors.New("pq: duplicate key value")),
+			expectedCode:     http.StatusConflict,
+			expectedResponse: "inserting order: pq: duplicate key value",
+		},
+		"field": {
+			err:          &AError{message: "special"},
+			expectedCode: http.StatusTeapot,
+		},
+		"no match": {
+			err:          &AError{message: "other"},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterMatcherHandlerOn_PassesMatchedErrorToHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWith error
+
+	RegisterMatcherHandlerOn(registry, func(err error) bool {
+		return err.Error() == "inner"
+	}, func(_ context.Context, err error) (int, any) {
+		calledWith = err
+
+		return http.StatusBadRequest, nil
+	})
+
+	inner := errors.New("inner")
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("outer: %w", inner))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Same(t, inner, calledWith)
+}
+
+func TestRegisterMatcherHandlerOn_PanicsOnNilMatcher(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterMatcherHandlerOn(registry, nil, func(context.Context, error) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for a nil matcher", result)
+}
+
+func TestRegisterMatcherHandlerOn_PassesValidation(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	for range 2 {
+		RegisterMatcherHandlerOn(registry, func(error) bool { return false }, func(context.Context, error) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+	}
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Handlers: 2}, registry.Stats())
+}
@@ -0,0 +1,70 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+)
+
+// MatchInfo describes the request an error is resolved for, so matchers can map the same error to different
+// responses depending on the request, see RegisterRequestMatcherHandlerOn.
+type MatchInfo struct {
+	RequestInfo
+
+	// Path is the path of the request, like /orders/42
+	Path string
+
+	// Header contains the headers of the request, it's nil if they aren't available
+	Header http.Header
+}
+
+// matchInfoContextKey is the context key under which the match info is stored
+type matchInfoContextKey struct{}
+
+// WithMatchInfo returns a copy of the context with the given match info, for requests that aren't served by gin.
+func WithMatchInfo(ctx context.Context, info MatchInfo) context.Context {
+	return context.WithValue(ctx, matchInfoContextKey{}, info)
+}
+
+// MatchInfoFromContext returns the match info set by WithMatchInfo. For contexts that belong to a gin request, it's
+// taken from the request instead. It's empty if no match info is available.
+func MatchInfoFromContext(ctx context.Context) MatchInfo {
+	if info, ok := ctx.Value(matchInfoContextKey{}).(MatchInfo); ok {
+		return info
+	}
+
+	c := ginContextFrom(ctx)
+	if c == nil || c.Request == nil {
+		return MatchInfo{}
+	}
+
+	return MatchInfo{
+		RequestInfo: RequestInfo{Method: c.Request.Method, Route: c.FullPath()},
+		Path:        c.Request.URL.Path,
+		Header:      c.Request.Header,
+	}
+}
+
+// RegisterRequestMatcherHandler registers an error handler for errors matching the predicate in DefaultErrorRegistry.
+func RegisterRequestMatcherHandler(matches func(err error, request MatchInfo) bool, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterRequestMatcherHandlerOn(DefaultErrorRegistry, matches, handler, options...)
+}
+
+// RegisterRequestMatcherHandlerOn registers an error handler in the given registry for errors matching the predicate,
+// which also receives the request the error is resolved for, so the same error can be a 404 on GET but a 409 on POST.
+// The request is taken from the context, see MatchInfoFromContext. Otherwise, it works like RegisterMatcherHandlerOn.
+// It panics if the predicate is nil.
+func RegisterRequestMatcherHandlerOn(registry *ErrorRegistry, matches func(err error, request MatchInfo) bool, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	if matches == nil {
+		panic("ginerr: can't register a handler for a nil matcher")
+	}
+
+	registry.mu.Lock()
+	registry.matchers++
+	key := matcherKey{id: registry.matchers}
+	registry.mu.Unlock()
+
+	errorHandler := newMatcherHandler(nil, handler, options)
+	errorHandler.matchesRequest = matches
+
+	registry.addHandler(key, errorHandler)
+}
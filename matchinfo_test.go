@@ -0,0 +1,100 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterRequestMatcherHandlerOn_MatchesOnRequest(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+
+	RegisterRequestMatcherHandlerOn(registry, func(err error, request MatchInfo) bool {
+		return errors.Is(err, errNotFound) && request.Method == http.MethodPost
+	}, func(context.Context, error) (int, any) {
+		return http.StatusConflict, nil
+	})
+
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	tests := map[string]struct {
+		ctx          context.Context
+		expectedCode int
+	}{
+		"get": {
+			ctx:          WithMatchInfo(context.Background(), MatchInfo{RequestInfo: RequestInfo{Method: http.MethodGet}}),
+			expectedCode: http.StatusNotFound,
+		},
+		"post": {
+			ctx:          WithMatchInfo(context.Background(), MatchInfo{RequestInfo: RequestInfo{Method: http.MethodPost}}),
+			expectedCode: http.StatusConflict,
+		},
+		"no request": {
+			ctx:          context.Background(),
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, _ := NewErrorResponseFrom(testData.ctx, registry, errNotFound)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
+
+func TestMatchInfoFromContext_UsesGinRequest(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(http.MethodDelete, "/orders/42", nil)
+	c.Request.Header.Set("X-Tenant", "acme")
+
+	// Act
+	result := MatchInfoFromContext(c)
+
+	// Assert
+	assert.Equal(t, http.MethodDelete, result.Method)
+	assert.Equal(t, "/orders/42", result.Path)
+	assert.Equal(t, "acme", result.Header.Get("X-Tenant"))
+}
+
+func TestMatchInfoFromContext_ReturnsEmptyWithoutRequest(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := MatchInfoFromContext(context.Background())
+
+	// Assert
+	assert.Equal(t, MatchInfo{}, result)
+}
+
+func TestRegisterRequestMatcherHandlerOn_PanicsOnNilMatcher(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterRequestMatcherHandlerOn(registry, nil, func(context.Context, error) (int, any) {
+			return http.StatusConflict, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for a nil matcher", result)
+}
@@ -0,0 +1,172 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// These tests document how tricky combinations of registrations and errors are matched, following errors.As
+
+type valueError struct{}
+
+func (valueError) Error() string {
+	return "value"
+}
+
+type sliceError []string
+
+func (e sliceError) Error() string {
+	return fmt.Sprint([]string(e))
+}
+
+// valueWrappingError is a comparable type, but not if it wraps an error that isn't comparable, like sliceError
+type valueWrappingError struct {
+	err error
+}
+
+func (e valueWrappingError) Error() string {
+	return "wrapping: " + e.err.Error()
+}
+
+func (e valueWrappingError) Unwrap() error {
+	return e.err
+}
+
+type embeddingError struct {
+	*AError
+}
+
+type unwrappingEmbeddingError struct {
+	*AError
+}
+
+func (e *unwrappingEmbeddingError) Unwrap() error {
+	return e.AError
+}
+
+func TestErrorResponseFrom_MatchesInterfaceInstances(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var instance temporaryError = dummyTemporaryError{}
+	RegisterErrorHandlerOn(registry, instance, func(context.Context, temporaryError) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", dummyTemporaryError{}))
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestErrorResponseFrom_MatchesNilInterfaceInstancesAsType(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn[temporaryError](registry, nil, func(context.Context, temporaryError) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, dummyTemporaryError{})
+	codeOther, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, http.StatusInternalServerError, codeOther)
+}
+
+func TestRegisterErrorHandlerOn_PanicsOnNilError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterErrorHandlerOn[error](registry, nil, func(context.Context, error) (int, any) {
+			return http.StatusBadRequest, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for a nil error, it would match every error, use RegisterDefaultHandler instead", result)
+}
+
+func TestErrorResponseFrom_MatchesUnhashableInstances(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Slices can't be used as map keys
+	RegisterErrorHandlerOn(registry, sliceError{}, func(_ context.Context, err sliceError) (int, any) {
+		return http.StatusBadRequest, []string(err)
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", sliceError{"a", "b"}))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, []string{"a", "b"}, response)
+}
+
+func TestErrorResponseFrom_MatchesStructsHoldingUnhashableErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// The type is comparable, but the slice it holds can't be used as a map key
+	RegisterErrorHandlerOn(registry, valueWrappingError{err: sliceError{}}, func(_ context.Context, err valueWrappingError) (int, any) {
+		return http.StatusBadRequest, err.Error()
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", valueWrappingError{err: sliceError{"a"}}))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "wrapping: [a]", response)
+}
+
+func TestErrorResponseFrom_DoesNotMatchPointersToValueTypes(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, valueError{}, func(context.Context, valueError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, valueError{})
+	codePointer, _ := NewErrorResponseFrom(context.Background(), registry, &valueError{})
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, http.StatusInternalServerError, codePointer)
+}
+
+func TestErrorResponseFrom_MatchesEmbeddedErrorsOnlyWithUnwrap(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &embeddingError{AError: &AError{}})
+	codeUnwrap, _ := NewErrorResponseFrom(context.Background(), registry, &unwrappingEmbeddingError{AError: &AError{}})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, http.StatusBadRequest, codeUnwrap)
+}
@@ -0,0 +1,90 @@
+package ginerr
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+)
+
+// Merge adds the registrations and remote error codes of other to the registry, in the order they were registered
+// in other, so registries of separate modules can be combined into one application registry at startup. If both
+// registries have a registration for the same error, type or remote error code, the one of other replaces the
+// existing one if overwrite is true, otherwise the existing one is kept and the conflict is returned as an
+// ErrDuplicateHandler. Replacing a registration keeps its API version variants that other doesn't have, see
+// ForAPIVersion. Registrations with a predicate never conflict. The registrations are added like any other
+// registration, so the conflict policy and soft limits of the registry apply, see SetConflictPolicy. Settings of
+// other, like its default handler, aren't merged, and later changes to other don't affect the registry.
+func (e *ErrorRegistry) Merge(other *ErrorRegistry, overwrite bool) error {
+	if other == e {
+		return nil
+	}
+
+	other.mu.RLock()
+	handlers := maps.Clone(other.handlers)
+	order := slices.Clone(other.order)
+	remoteErrors := maps.Clone(other.remoteErrors)
+	other.mu.RUnlock()
+
+	var errs []error
+
+	for _, key := range order {
+		mergedKey, previous, exists := e.mergeKey(key)
+		if exists && !overwrite {
+			errs = append(errs, fmt.Errorf("%w: %v was already registered", ErrDuplicateHandler, describeRegistration(mergedKey, previous)))
+
+			continue
+		}
+
+		handler := handlers[key]
+
+		// The registration and its variants are added separately, so variants of the registry are kept
+		if !handler.placeholder {
+			base := *handler
+			base.variants = nil
+
+			e.addHandler(mergedKey, &base)
+		}
+
+		for _, version := range slices.Sorted(maps.Keys(handler.variants)) {
+			e.addHandler(mergedKey, handler.variants[version])
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	// The remote error codes are stored in a map, sort them to get a deterministic result
+	for _, code := range slices.Sorted(maps.Keys(remoteErrors)) {
+		if _, exists := e.remoteErrors[code]; exists && !overwrite {
+			errs = append(errs, fmt.Errorf("%w: remote error code %q was already registered", ErrDuplicateHandler, code))
+
+			continue
+		}
+
+		e.remoteErrors[code] = remoteErrors[code]
+	}
+
+	return errors.Join(errs...)
+}
+
+// mergeKey returns the key a registration of another registry is merged under and the registration that already
+// exists under it, if any. Predicates are numbered per registry, so they get a new number instead of replacing
+// another predicate.
+func (e *ErrorRegistry) mergeKey(key error) (error, *errorHandler, bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	switch key.(type) {
+	case matcherKey:
+		e.matchers++
+		key = matcherKey{id: e.matchers}
+	case equalityKey:
+		e.matchers++
+		key = equalityKey{id: e.matchers}
+	}
+
+	previous, exists := e.handlers[key]
+
+	return key, previous, exists
+}
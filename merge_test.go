@@ -0,0 +1,209 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorRegistry_Merge_AddsRegistrations(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	other := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+	RegisterMatcherHandlerOn(registry, func(err error) bool {
+		return strings.Contains(err.Error(), "duplicate")
+	}, func(context.Context, error) (int, any) {
+		return http.StatusConflict, nil
+	})
+
+	RegisterTypeOn(other, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterMatcherHandlerOn(other, func(err error) bool {
+		return strings.Contains(err.Error(), "locked")
+	}, func(context.Context, error) (int, any) {
+		return http.StatusLocked, nil
+	})
+	RegisterRemoteErrorOn(other, "not_found", errNotFound)
+
+	// Act
+	err := registry.Merge(other, false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Handlers: 4, StringErrors: 1, RemoteErrors: 1}, registry.Stats())
+
+	tests := map[string]struct {
+		err          error
+		expectedCode int
+	}{
+		"existing":         {err: errNotFound, expectedCode: http.StatusNotFound},
+		"existing matcher": {err: errors.New("duplicate key"), expectedCode: http.StatusConflict},
+		"merged":           {err: &AError{}, expectedCode: http.StatusBadRequest},
+		"merged matcher":   {err: errors.New("row locked"), expectedCode: http.StatusLocked},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
+
+func TestErrorRegistry_Merge_ResolvesConflicts(t *testing.T) {
+	t.Parallel()
+	errNotFound := errors.New("not found")
+
+	tests := map[string]struct {
+		overwrite     bool
+		expectedCode  int
+		expectedError string
+	}{
+		"keep existing": {
+			overwrite:    false,
+			expectedCode: http.StatusNotFound,
+			expectedError: "multiple handlers registered for the same type: error \"not found\" was already registered\n" +
+				"multiple handlers registered for the same type: remote error code \"not_found\" was already registered",
+		},
+		"overwrite": {
+			overwrite:    true,
+			expectedCode: http.StatusGone,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+			other := NewErrorRegistry()
+
+			RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+				return http.StatusNotFound, nil
+			})
+			RegisterRemoteErrorOn(registry, "not_found", errNotFound)
+
+			RegisterErrorHandlerOn(other, errNotFound, func(context.Context, error) (int, any) {
+				return http.StatusGone, nil
+			})
+			RegisterRemoteErrorOn(other, "not_found", errNotFound)
+
+			// Act
+			err := registry.Merge(other, testData.overwrite)
+
+			// Assert
+			if testData.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testData.expectedError)
+			}
+
+			code, _ := NewErrorResponseFrom(context.Background(), registry, errNotFound)
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, Stats{Handlers: 1, StringErrors: 1, RemoteErrors: 1}, registry.Stats())
+		})
+	}
+}
+
+func TestErrorRegistry_Merge_KeepsAPIVersionVariants(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	other := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "a-base"
+	})
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusGone, "a-v2"
+	}, ForAPIVersion("v2"))
+
+	RegisterTypeOn(other, func(context.Context, *AError) (int, any) {
+		return http.StatusPaymentRequired, "b-base"
+	})
+	RegisterTypeOn(other, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, "b-v3"
+	}, ForAPIVersion("v3"))
+
+	// Act
+	err := registry.Merge(other, true)
+
+	// Assert
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		expectedCode     int
+		expectedResponse any
+	}{
+		"v1": {expectedCode: http.StatusPaymentRequired, expectedResponse: "b-base"},
+		"v2": {expectedCode: http.StatusGone, expectedResponse: "a-v2"},
+		"v3": {expectedCode: http.StatusConflict, expectedResponse: "b-v3"},
+	}
+
+	for version, testData := range tests {
+		code, response := NewErrorResponseFrom(WithAPIVersion(context.Background(), version), registry, &AError{})
+
+		assert.Equal(t, testData.expectedCode, code, version)
+		assert.Equal(t, testData.expectedResponse, response, version)
+	}
+}
+
+func TestErrorRegistry_Merge_AppliesConflictPolicy(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetConflictPolicy(ConflictReject)
+
+	other := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterTypeOn(other, func(context.Context, *AError) (int, any) {
+		return http.StatusGone, nil
+	})
+
+	// Act
+	err := registry.Merge(other, true)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.ErrorIs(t, registry.Validate(), ErrDuplicateHandler)
+
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestErrorRegistry_Merge_IgnoresItself(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	err := registry.Merge(registry, false)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, Stats{Handlers: 1}, registry.Stats())
+}
@@ -0,0 +1,199 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ginErrorKey is the key under which the gin error that is being resolved is stored in gin contexts
+const ginErrorKey = "github.com/ing-bank/ginerr/v3.ginError"
+
+// MiddlewareOption configures the middleware returned by Middleware and MiddlewareFrom.
+type MiddlewareOption func(config *middlewareConfig)
+
+// middlewareConfig is the configuration of the middleware, built from the options
+type middlewareConfig struct {
+	// unhandledLogger logs errors that were resolved by the default handler, if set
+	unhandledLogger *slog.Logger
+
+	// selectError picks the error that is resolved, see SelectError
+	selectError ErrorSelector
+
+	// appendResolved appends the resolved error to c.Errors, see AppendResolvedError
+	appendResolved bool
+}
+
+// ResolvedError is the Meta of the gin error that is appended by AppendResolvedError.
+type ResolvedError struct {
+	// Status is the status code of the response that was written
+	Status int
+
+	// Handler is the HandlerName of the ErrorResponse that was written, like "default" if no handler matched
+	Handler string
+}
+
+// AppendResolvedError makes the middleware append a gin error to c.Errors after writing the response, with the same
+// error and type as the resolved one and a ResolvedError as Meta. Middleware that reads c.Errors, like loggers,
+// can use it to report which status and handler were used.
+func AppendResolvedError() MiddlewareOption {
+	return func(config *middlewareConfig) {
+		config.appendResolved = true
+	}
+}
+
+// ErrorSelector picks which of the errors of a request is resolved, the errors are deduplicated and in the order
+// they were added with c.Error. It's never called without errors.
+type ErrorSelector func(c *gin.Context, errs []*gin.Error) *gin.Error
+
+// FirstError is an ErrorSelector that resolves the first error, like the root cause of a failing request.
+func FirstError(_ *gin.Context, errs []*gin.Error) *gin.Error {
+	return errs[0]
+}
+
+// LastError is an ErrorSelector that resolves the last error, it's used by default.
+func LastError(_ *gin.Context, errs []*gin.Error) *gin.Error {
+	return errs[len(errs)-1]
+}
+
+// SelectError makes the middleware use the selector to decide which error is resolved if c.Error was called
+// multiple times, for example to pick the error with the highest priority.
+func SelectError(selector ErrorSelector) MiddlewareOption {
+	return func(config *middlewareConfig) {
+		config.selectError = selector
+	}
+}
+
+// LogUnhandledErrors makes the middleware log errors that were resolved by the default handler, or by strict mode
+// (see SetStrictMode), with the method, path, status and the type of the error. This includes errors that didn't
+// match any registered handler and errors whose response was rejected by a policy, to help discover error types
+// that are still missing a handler.
+func LogUnhandledErrors(logger *slog.Logger) MiddlewareOption {
+	return func(config *middlewareConfig) {
+		config.unhandledLogger = logger
+	}
+}
+
+// Middleware returns a gin middleware that resolves the last error added with c.Error using the registry attached
+// to the request (see WithRegistry) or the DefaultErrorRegistry, and writes the response. Nothing is written if
+// the response was already written by the handler, but the error is still resolved and logged.
+func Middleware(options ...MiddlewareOption) gin.HandlerFunc {
+	config := newMiddlewareConfig(options)
+
+	return func(c *gin.Context) {
+		c.Next()
+
+		config.resolveError(c, registryFromContext(c))
+	}
+}
+
+// MiddlewareFrom returns a gin middleware like Middleware, but using the given registry.
+func MiddlewareFrom(registry *ErrorRegistry, options ...MiddlewareOption) gin.HandlerFunc {
+	config := newMiddlewareConfig(options)
+
+	return func(c *gin.Context) {
+		c.Next()
+
+		config.resolveError(c, registry)
+	}
+}
+
+// newMiddlewareConfig applies the options to an empty configuration.
+func newMiddlewareConfig(options []MiddlewareOption) *middlewareConfig {
+	config := &middlewareConfig{selectError: LastError}
+
+	for _, option := range options {
+		option(config)
+	}
+
+	return config
+}
+
+// resolveError writes the response of the selected gin error. If the response was already written, the error is
+// still resolved, logged and appended, only writing the response is skipped, see abortWithResponse.
+func (m *middlewareConfig) resolveError(c *gin.Context, registry *ErrorRegistry) {
+	if len(c.Errors) == 0 {
+		return
+	}
+
+	written := c.Writer.Written()
+	err := m.selectError(c, uniqueErrors(c.Errors))
+
+	response := abortWithErrorFrom(c, registry, err)
+
+	// The client got the status of the earlier response
+	status := response.Code
+	if written {
+		status = c.Writer.Status()
+	}
+
+	if m.appendResolved {
+		c.Errors = append(c.Errors, &gin.Error{
+			Err:  err.Err,
+			Type: err.Type,
+			Meta: ResolvedError{Status: status, Handler: response.HandlerName},
+		})
+	}
+
+	if m.unhandledLogger == nil {
+		return
+	}
+
+	if response.HandlerName != defaultHandlerName && response.HandlerName != unmappedHandlerName {
+		return
+	}
+
+	m.unhandledLogger.LogAttrs(c, slog.LevelWarn, "unhandled error",
+		slog.String("method", c.Request.Method),
+		slog.String("path", c.Request.URL.Path),
+		slog.Int("status", status),
+		slog.String("type", fmt.Sprintf("%T", err.Err)),
+		slog.String("error", err.Error()),
+	)
+}
+
+// uniqueErrors removes gin errors that wrap an error that was already added, keeping the first one. Errors that
+// can't be compared, like structs holding a slice error, are always kept.
+func uniqueErrors(errs []*gin.Error) []*gin.Error {
+	seen := make(map[error]struct{}, len(errs))
+	result := make([]*gin.Error, 0, len(errs))
+
+	for _, ginErr := range errs {
+		if ginErr.Err != nil && reflect.ValueOf(ginErr.Err).Comparable() {
+			if _, ok := seen[ginErr.Err]; ok {
+				continue
+			}
+
+			seen[ginErr.Err] = struct{}{}
+		}
+
+		result = append(result, ginErr)
+	}
+
+	return result
+}
+
+// GinErrorFromContext returns the gin error that is being resolved, so handlers can use the Type and Meta
+// set with c.Error(err).SetMeta(meta). The boolean is false if the error didn't come from c.Error or
+// wasn't resolved through AbortWithError, WrapHandler or Middleware.
+func GinErrorFromContext(ctx context.Context) (*gin.Error, bool) {
+	c := ginContextFrom(ctx)
+	if c == nil {
+		return nil, false
+	}
+
+	ginErr, ok := c.Value(ginErrorKey).(*gin.Error)
+
+	return ginErr, ok
+}
+
+// setGinError stores the gin error in the chain of err in the gin context, for GinErrorFromContext.
+func setGinError(c *gin.Context, err error) {
+	var ginErr *gin.Error
+	if errorsAs(err, &ginErr) {
+		c.Set(ginErrorKey, ginErr)
+	}
+}
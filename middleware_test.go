@@ -0,0 +1,459 @@
+package ginerr
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareFrom_WritesResponseOfLastError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, err.message
+	})
+
+	engine := newTestEngine(MiddlewareFrom(registry), func(c *gin.Context) {
+		_ = c.Error(assert.AnError)
+		_ = c.Error(&AError{message: "last"})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `"last"`, recorder.Body.String())
+}
+
+func TestMiddleware_UsesRegistryFromContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, "conflict"
+	})
+
+	engine := newTestEngine(WithRegistry(registry), Middleware(), func(c *gin.Context) {
+		_ = c.Error(&AError{})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.JSONEq(t, `"conflict"`, recorder.Body.String())
+}
+
+func TestMiddlewareFrom_DoesNotOverwriteWrittenResponses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	engine := newTestEngine(MiddlewareFrom(NewErrorRegistry()), func(c *gin.Context) {
+		c.String(http.StatusOK, "ok")
+		_ = c.Error(assert.AnError)
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "ok", recorder.Body.String())
+}
+
+func TestMiddlewareFrom_LogsAndAppendsErrorsOfWrittenResponses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	var ginErrors []*gin.Error
+
+	engine := newTestEngine(func(c *gin.Context) {
+		c.Next()
+
+		ginErrors = c.Errors
+	}, MiddlewareFrom(NewErrorRegistry(), LogUnhandledErrors(logger), AppendResolvedError()), func(c *gin.Context) {
+		c.String(http.StatusAccepted, "accepted")
+		_ = c.Error(assert.AnError)
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusAccepted, recorder.Code)
+	assert.Equal(t, "accepted", recorder.Body.String())
+
+	require.Len(t, ginErrors, 2)
+	assert.Equal(t, ResolvedError{Status: http.StatusAccepted, Handler: "default"}, ginErrors[1].Meta)
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "unhandled error", lines[0]["msg"])
+	assert.InDelta(t, http.StatusAccepted, lines[0]["status"], 0)
+}
+
+func TestMiddlewareFrom_DoesNothingOnNoErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	engine := newTestEngine(MiddlewareFrom(NewErrorRegistry()), func(c *gin.Context) {
+		c.Status(http.StatusNoContent)
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, recorder.Code)
+}
+
+func TestGinErrorFromContext_ReturnsMetaToHandlers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWithType gin.ErrorType
+	RegisterErrorHandlerOn(registry, &AError{}, func(ctx context.Context, _ *AError) (int, any) {
+		ginErr, ok := GinErrorFromContext(ctx)
+		if !ok {
+			return http.StatusInternalServerError, nil
+		}
+
+		calledWithType = ginErr.Type
+
+		return http.StatusBadRequest, ginErr.Meta
+	})
+
+	engine := newTestEngine(MiddlewareFrom(registry), func(c *gin.Context) {
+		_ = c.Error(&AError{}).SetType(gin.ErrorTypePublic).SetMeta(map[string]string{"endpoint": "orders"})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `{"endpoint":"orders"}`, recorder.Body.String())
+	assert.Equal(t, gin.ErrorTypePublic, calledWithType)
+}
+
+func TestGinErrorFromContext_ReturnsFalseOnNoGinError(t *testing.T) {
+	t.Parallel()
+	// Act
+	_, ok := GinErrorFromContext(context.Background())
+
+	// Assert
+	assert.False(t, ok)
+}
+
+func TestMiddlewareFrom_LogsUnhandledErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	engine := newTestEngine(MiddlewareFrom(registry, LogUnhandledErrors(logger)), func(c *gin.Context) {
+		_ = c.Error(&BError{message: "b"})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 1)
+
+	assert.Equal(t, "unhandled error", lines[0]["msg"])
+	assert.Equal(t, http.MethodGet, lines[0]["method"])
+	assert.Equal(t, "/", lines[0]["path"])
+	assert.InDelta(t, http.StatusInternalServerError, lines[0]["status"], 0)
+	assert.Equal(t, "*ginerr.BError", lines[0]["type"])
+	assert.Equal(t, "b", lines[0]["error"])
+}
+
+func TestMiddlewareFrom_DoesNotLogHandledErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	var buffer bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+	engine := newTestEngine(MiddlewareFrom(registry, LogUnhandledErrors(logger)), func(c *gin.Context) {
+		_ = c.Error(&AError{})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Empty(t, buffer.String())
+}
+
+func TestMiddlewareFrom_LogsErrorsResolvedByDefaultHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusOK, nil
+	})
+
+	registry.RegisterPolicy(ForbidStatuses(200, 399))
+	registry.SetLegacyResolver(func(err error) (int, any, bool) {
+		return http.StatusConflict, nil, errors.Is(err, assert.AnError)
+	})
+
+	tests := map[string]struct {
+		err      error
+		expected int
+	}{
+		"legacy":           {err: assert.AnError, expected: 0},
+		"policy violation": {err: &AError{}, expected: 1},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var buffer bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buffer, nil))
+
+			engine := newTestEngine(MiddlewareFrom(registry, LogUnhandledErrors(logger)), func(c *gin.Context) {
+				_ = c.Error(testData.err)
+			})
+
+			// Act
+			serveTestRequest(engine)
+
+			// Assert
+			assert.Len(t, decodeLogLines(t, &buffer), testData.expected)
+		})
+	}
+}
+
+func TestMiddlewareFrom_SelectsError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, err.message
+	})
+
+	tests := map[string]struct {
+		options  []MiddlewareOption
+		expected string
+	}{
+		"default": {
+			expected: `"second"`,
+		},
+		"first": {
+			options:  []MiddlewareOption{SelectError(FirstError)},
+			expected: `"first"`,
+		},
+		"last": {
+			options:  []MiddlewareOption{SelectError(LastError)},
+			expected: `"second"`,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			engine := newTestEngine(MiddlewareFrom(registry, testData.options...), func(c *gin.Context) {
+				_ = c.Error(&AError{message: "first"})
+				_ = c.Error(&AError{message: "second"})
+			})
+
+			// Act
+			recorder := serveTestRequest(engine)
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.JSONEq(t, testData.expected, recorder.Body.String())
+		})
+	}
+}
+
+func TestMiddlewareFrom_DeduplicatesErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errDuplicate := &AError{message: "duplicate"}
+
+	var selected []*gin.Error
+
+	selector := func(c *gin.Context, errs []*gin.Error) *gin.Error {
+		selected = errs
+
+		return LastError(c, errs)
+	}
+
+	engine := newTestEngine(MiddlewareFrom(registry, SelectError(selector)), func(c *gin.Context) {
+		_ = c.Error(errDuplicate)
+		_ = c.Error(&BError{message: "other"})
+		_ = c.Error(errDuplicate)
+	})
+
+	// Act
+	serveTestRequest(engine)
+
+	// Assert
+	require.Len(t, selected, 2)
+	assert.Same(t, errDuplicate, selected[0].Err)
+	assert.Equal(t, &BError{message: "other"}, selected[1].Err)
+}
+
+func TestMiddlewareFrom_KeepsErrorsThatCantBeCompared(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errValue := valueWrappingError{err: sliceError{"a"}}
+
+	var selected []*gin.Error
+
+	selector := func(c *gin.Context, errs []*gin.Error) *gin.Error {
+		selected = errs
+
+		return LastError(c, errs)
+	}
+
+	engine := newTestEngine(MiddlewareFrom(registry, SelectError(selector)), func(c *gin.Context) {
+		_ = c.Error(errValue)
+		_ = c.Error(errValue)
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Len(t, selected, 2)
+}
+
+func TestMiddlewareFrom_AppendsResolvedError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	var ginErrors []*gin.Error
+
+	engine := newTestEngine(func(c *gin.Context) {
+		c.Next()
+
+		ginErrors = c.Errors
+	}, MiddlewareFrom(registry, AppendResolvedError()), func(c *gin.Context) {
+		_ = c.Error(&AError{message: "a"}).SetType(gin.ErrorTypePublic)
+	})
+
+	// Act
+	serveTestRequest(engine)
+
+	// Assert
+	require.Len(t, ginErrors, 2)
+
+	assert.Equal(t, &AError{message: "a"}, ginErrors[1].Err)
+	assert.Equal(t, gin.ErrorTypePublic, ginErrors[1].Type)
+	assert.Equal(t, ResolvedError{Status: http.StatusBadRequest, Handler: "type *ginerr.AError"}, ginErrors[1].Meta)
+}
+
+func TestMiddlewareFrom_AppendsResolvedErrorOfWrittenResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusOK, nil
+	})
+
+	registry.RegisterPolicy(ForbidStatuses(200, 399))
+	registry.SetLegacyResolver(func(err error) (int, any, bool) {
+		return http.StatusConflict, nil, errors.Is(err, assert.AnError)
+	})
+
+	tests := map[string]struct {
+		err      error
+		expected ResolvedError
+	}{
+		"legacy": {
+			err:      assert.AnError,
+			expected: ResolvedError{Status: http.StatusConflict, Handler: "legacy"},
+		},
+		"policy violation": {
+			err:      &AError{},
+			expected: ResolvedError{Status: http.StatusInternalServerError, Handler: "default"},
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var ginErrors []*gin.Error
+
+			engine := newTestEngine(func(c *gin.Context) {
+				c.Next()
+
+				ginErrors = c.Errors
+			}, MiddlewareFrom(registry, AppendResolvedError()), func(c *gin.Context) {
+				_ = c.Error(testData.err)
+			})
+
+			// Act
+			recorder := serveTestRequest(engine)
+
+			// Assert
+			assert.Equal(t, testData.expected.Status, recorder.Code)
+
+			require.Len(t, ginErrors, 2)
+			assert.Equal(t, testData.expected, ginErrors[1].Meta)
+		})
+	}
+}
+
+func TestMiddlewareFrom_DoesNotAppendResolvedErrorByDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var ginErrors []*gin.Error
+
+	engine := newTestEngine(func(c *gin.Context) {
+		c.Next()
+
+		ginErrors = c.Errors
+	}, MiddlewareFrom(NewErrorRegistry()), func(c *gin.Context) {
+		_ = c.Error(&AError{})
+	})
+
+	// Act
+	serveTestRequest(engine)
+
+	// Assert
+	assert.Len(t, ginErrors, 1)
+}
@@ -0,0 +1,57 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// MultiStatusResponse is the body of a 207 Multi-Status response, used by bulk APIs to report the
+// result of every item separately instead of failing the whole request on one bad item.
+type MultiStatusResponse struct {
+	// Items contains the result of every item, sorted by ID
+	Items []ItemStatus `json:"items"`
+}
+
+// ItemStatus is the result of a single item in a MultiStatusResponse.
+type ItemStatus struct {
+	// ID is the identifier of the item
+	ID string `json:"id"`
+
+	// Status is the status code of the item
+	Status int `json:"status"`
+
+	// Response is the response of the error handler of the item, empty on success
+	Response any `json:"response,omitempty"`
+}
+
+// NewMultiStatusResponse returns a 207 Multi-Status response using the registry attached to the context or the
+// DefaultErrorRegistry.
+func NewMultiStatusResponse(ctx context.Context, errs map[string]error) (int, any) {
+	return NewMultiStatusResponseFrom(ctx, registryFromContext(ctx), errs)
+}
+
+// NewMultiStatusResponseFrom returns a 207 Multi-Status response using the given registry, every error in errs is
+// resolved separately. Items with a nil error are reported with a 200 OK status.
+func NewMultiStatusResponseFrom(ctx context.Context, registry *ErrorRegistry, errs map[string]error) (int, any) {
+	response := &MultiStatusResponse{Items: make([]ItemStatus, 0, len(errs))}
+
+	for id, err := range errs {
+		if err == nil {
+			response.Items = append(response.Items, ItemStatus{ID: id, Status: http.StatusOK})
+
+			continue
+		}
+
+		code, itemResponse := NewErrorResponseFrom(ctx, registry, err)
+
+		response.Items = append(response.Items, ItemStatus{ID: id, Status: code, Response: itemResponse})
+	}
+
+	slices.SortFunc(response.Items, func(a, b ItemStatus) int {
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return http.StatusMultiStatus, response
+}
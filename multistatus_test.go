@@ -0,0 +1,50 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMultiStatusResponseFrom_ReturnsResultPerItem(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, err.message
+	})
+
+	errs := map[string]error{
+		"c": &AError{message: "invalid"},
+		"a": nil,
+		"b": assert.AnError,
+	}
+
+	// Act
+	code, response := NewMultiStatusResponseFrom(context.Background(), registry, errs)
+
+	// Assert
+	assert.Equal(t, http.StatusMultiStatus, code)
+
+	expected := &MultiStatusResponse{
+		Items: []ItemStatus{
+			{ID: "a", Status: http.StatusOK},
+			{ID: "b", Status: http.StatusInternalServerError},
+			{ID: "c", Status: http.StatusBadRequest, Response: "invalid"},
+		},
+	}
+	assert.Equal(t, expected, response)
+}
+
+func TestNewMultiStatusResponseFrom_ReturnsEmptyItemsOnNoErrors(t *testing.T) {
+	t.Parallel()
+	// Act
+	code, response := NewMultiStatusResponseFrom(context.Background(), NewErrorRegistry(), nil)
+
+	// Assert
+	assert.Equal(t, http.StatusMultiStatus, code)
+	assert.Equal(t, &MultiStatusResponse{Items: []ItemStatus{}}, response)
+}
@@ -0,0 +1,52 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// nilHandlerName is the HandlerName of responses of the nil handler, see RegisterNilHandler
+const nilHandlerName = "nil"
+
+// RegisterNilHandler sets the handler that is called when a nil error is resolved, which usually means a handler
+// returned a nil error together with a failure. The handler could return a 204 No Content, or panic in debug mode
+// like PanicOnNilInDebug. If no nil handler is set, which is the default, nil errors are resolved like any other
+// error, so in most cases by the default handler.
+func (e *ErrorRegistry) RegisterNilHandler(handler func(ctx context.Context) (int, any)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.nilHandler = handler
+}
+
+// resolveNil calls the nil handler if the error is nil and one was set, the boolean is false otherwise.
+func (e *ErrorRegistry) resolveNil(ctx context.Context, err error) (ErrorResponse, bool) {
+	if err != nil {
+		return ErrorResponse{}, false
+	}
+
+	e.mu.RLock()
+	handler := e.nilHandler
+	e.mu.RUnlock()
+
+	if handler == nil {
+		return ErrorResponse{}, false
+	}
+
+	code, response := handler(ctx)
+
+	return newErrorResponse(code, response, nil, nilHandlerName), true
+}
+
+// PanicOnNilInDebug can be used as the nil handler of a registry, in gin's debug mode it panics so resolving nil
+// errors is caught during development. Outside of debug mode it returns a 500 without a body, like the default
+// handler of NewErrorRegistry.
+func PanicOnNilInDebug(context.Context) (int, any) {
+	if gin.IsDebugging() {
+		panic("ginerr: resolved a nil error")
+	}
+
+	return http.StatusInternalServerError, nil
+}
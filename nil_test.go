@@ -0,0 +1,98 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorRegistry_RegisterNilHandler_IsCalledForNilErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	registry.RegisterNilHandler(func(context.Context) (int, any) {
+		return http.StatusNoContent, nil
+	})
+
+	// Act
+	result := ResolveFrom(context.Background(), registry, error(nil))
+
+	// Assert
+	assert.Equal(t, http.StatusNoContent, result.Code)
+	assert.Equal(t, "nil", result.HandlerName)
+}
+
+func TestErrorRegistry_RegisterNilHandler_IsNotCalledForErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	registry.RegisterNilHandler(func(context.Context) (int, any) {
+		return http.StatusNoContent, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, assert.AnError)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestNewErrorResponseFrom_UsesDefaultHandlerForNilWithoutNilHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWith error = assert.AnError
+	registry.RegisterDefaultHandler(func(_ context.Context, err error) (int, any) {
+		calledWith = err
+
+		return http.StatusInternalServerError, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, error(nil))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.NoError(t, calledWith)
+}
+
+//nolint:paralleltest // Can't be used, we change the gin mode
+func TestPanicOnNilInDebug_PanicsInDebugMode(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.DebugMode)
+	defer gin.SetMode(gin.TestMode)
+
+	registry := NewErrorRegistry()
+	registry.RegisterNilHandler(PanicOnNilInDebug)
+
+	// Act
+	result := func() {
+		_, _ = NewErrorResponseFrom(context.Background(), registry, error(nil))
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: resolved a nil error", result)
+}
+
+//nolint:paralleltest // Can't be used, we change the gin mode
+func TestPanicOnNilInDebug_ReturnsInternalServerErrorOutsideDebugMode(t *testing.T) {
+	// Arrange
+	gin.SetMode(gin.ReleaseMode)
+	defer gin.SetMode(gin.TestMode)
+
+	registry := NewErrorRegistry()
+	registry.RegisterNilHandler(PanicOnNilInDebug)
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, error(nil))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+}
@@ -0,0 +1,70 @@
+package ginerr
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RegistrationOption configures a single registration, like Cacheable.
+type RegistrationOption func(handler *errorHandler)
+
+// Cacheable declares that clients may cache the response of the handler for the given duration, like 60 seconds for
+// a 404 or a year for a 410 Gone. It's sent as a Cache-Control header, unless the handler sets one itself, and is
+// available as HandlerInfo.CacheMaxAge so documentation and SDK generators can use it.
+func Cacheable(maxAge time.Duration) RegistrationOption {
+	return func(handler *errorHandler) {
+		handler.cacheMaxAge = maxAge
+	}
+}
+
+// WithPriority sets the priority of the registration, registrations with a higher priority are evaluated first for
+// every error in the tree, regardless of the kind of matching or the order they were registered in, see Rules. The
+// default priority is 0, negative priorities are evaluated after registrations without a priority. Fallbacks, like
+// RegisterPackageHandlerOn, are always evaluated after other registrations.
+func WithPriority(priority int) RegistrationOption {
+	return func(handler *errorHandler) {
+		handler.priority = priority
+	}
+}
+
+// ExactType makes the registration match only the resolved error itself, without unwrapping it or consulting its
+// Is and As methods, so wrapped occurrences deliberately fall through to other handlers. A string error only
+// matches if it's returned as-is.
+func ExactType() RegistrationOption {
+	return func(handler *errorHandler) {
+		handler.exactType = true
+	}
+}
+
+// NormalizedMatch makes a registration of a string error also match errors with the same message, ignoring case and
+// differences in whitespace, so a library changing "Record not found" into "record  not found" doesn't silently
+// break the registration. It has no effect on other registrations.
+func NormalizedMatch() RegistrationOption {
+	return func(handler *errorHandler) {
+		handler.normalized = true
+	}
+}
+
+// normalizeMessage lowercases the message and collapses all whitespace into single spaces, see NormalizedMatch.
+func normalizeMessage(message string) string {
+	return strings.ToLower(strings.Join(strings.Fields(message), " "))
+}
+
+// cacheHeaders adds the Cache-Control header of Cacheable to the headers returned by the handler.
+func (h *errorHandler) cacheHeaders(headers http.Header) http.Header {
+	if h.cacheMaxAge <= 0 || headers.Get("Cache-Control") != "" {
+		return headers
+	}
+
+	// The handler might return the same headers for every request
+	result := headers.Clone()
+	if result == nil {
+		result = make(http.Header, 1)
+	}
+
+	result.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(h.cacheMaxAge/time.Second)))
+
+	return result
+}
@@ -0,0 +1,291 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheable_SetsCacheControl(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusNotFound, nil
+	}, Cacheable(time.Minute))
+
+	// Act
+	code, _, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "public, max-age=60", headers.Get("Cache-Control"))
+}
+
+func TestCacheable_KeepsCacheControlOfHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	handlerHeaders := http.Header{"Cache-Control": []string{"no-store"}}
+
+	RegisterErrorHandlerWithHeadersOn(registry, &AError{}, func(context.Context, *AError) (int, any, http.Header) {
+		return http.StatusNotFound, nil, handlerHeaders
+	}, Cacheable(time.Minute))
+
+	// Act
+	_, _, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, "no-store", headers.Get("Cache-Control"))
+}
+
+func TestCacheable_DoesNotModifyHandlerHeaders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	handlerHeaders := http.Header{"Retry-After": []string{"10"}}
+
+	RegisterErrorHandlerWithHeadersOn(registry, &AError{}, func(context.Context, *AError) (int, any, http.Header) {
+		return http.StatusGone, nil, handlerHeaders
+	}, Cacheable(365*24*time.Hour))
+
+	// Act
+	_, _, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, "public, max-age=31536000", headers.Get("Cache-Control"))
+	assert.Equal(t, "10", headers.Get("Retry-After"))
+	assert.NotContains(t, handlerHeaders, "Cache-Control")
+}
+
+func TestCacheable_IsAvailableInHandlerInfo(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusNotFound, nil
+	}, Cacheable(time.Minute))
+
+	// Act
+	var result []HandlerInfo
+
+	for info := range registry.All() {
+		result = append(result, info)
+	}
+
+	// Assert
+	require.Len(t, result, 1)
+	assert.Equal(t, time.Minute, result[0].CacheMaxAge)
+}
+
+func TestNewErrorResponseWithHeadersFrom_ReturnsNoCacheControlByDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	_, _, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Nil(t, headers)
+}
+
+func TestWithPriority_ChangesWhichHandlerWins(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		order        []MatcherKind
+		options      []RegistrationOption
+		expectedCode int
+	}{
+		"registration order": {
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		"higher priority": {
+			options:      []RegistrationOption{WithPriority(1)},
+			expectedCode: http.StatusTooManyRequests,
+		},
+		"lower priority": {
+			order:        []MatcherKind{MatchType},
+			options:      []RegistrationOption{WithPriority(-1)},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+			registry.SetMatcherOrder(testData.order...)
+
+			RegisterTypeOn(registry, func(context.Context, temporaryError) (int, any) {
+				return http.StatusServiceUnavailable, nil
+			})
+
+			RegisterTypeOn(registry, func(context.Context, dummyTemporaryError) (int, any) {
+				return http.StatusTooManyRequests, nil
+			}, testData.options...)
+
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, dummyTemporaryError{})
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
+
+func TestWithPriority_IsAvailableInRules(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterTypeOn(registry, func(context.Context, *BError) (int, any) {
+		return http.StatusNotFound, nil
+	}, WithPriority(10))
+
+	// Act
+	result := registry.Rules()
+
+	// Assert
+	require.Len(t, result, 2)
+	assert.Equal(t, reflect.TypeFor[*BError](), result[0].Type)
+	assert.Equal(t, 10, result[0].Priority)
+	assert.Equal(t, reflect.TypeFor[*AError](), result[1].Type)
+}
+
+func TestExactType_OnlyMatchesResolvedError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	}, ExactType())
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	}, ExactType())
+	RegisterTypeOn(registry, func(context.Context, error) (int, any) {
+		return http.StatusConflict, nil
+	}, WithPriority(-1))
+
+	tests := map[string]struct {
+		err          error
+		expectedCode int
+	}{
+		"type": {
+			err:          &AError{},
+			expectedCode: http.StatusBadRequest,
+		},
+		"wrapped type": {
+			err:          fmt.Errorf("wrapped: %w", &AError{}),
+			expectedCode: http.StatusConflict,
+		},
+		"string error": {
+			err:          errNotFound,
+			expectedCode: http.StatusNotFound,
+		},
+		"wrapped string error": {
+			err:          fmt.Errorf("wrapped: %w", errNotFound),
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
+
+func TestNormalizedMatch_IgnoresCaseAndWhitespace(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("Record not found")
+
+	// The handler receives the registered error, like other string error handlers
+	RegisterErrorHandlerOn(registry, errNotFound, func(_ context.Context, err error) (int, any) {
+		return http.StatusNotFound, err
+	}, NormalizedMatch())
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"same error": {
+			err:              errNotFound,
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: errNotFound,
+		},
+		"changed capitalization": {
+			err:              errors.New("record not found"),
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: errNotFound,
+		},
+		"changed whitespace": {
+			err:              fmt.Errorf("loading: %w", errors.New(" Record\tnot  found\n")),
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: errNotFound,
+		},
+		"other message": {
+			err:          errors.New("record not found: 42"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestNormalizedMatch_IsExactByDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, errors.New("Record not found"), func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, errors.New("record not found"))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
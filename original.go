@@ -0,0 +1,53 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+)
+
+// RegisterErrorHandlerWithOriginal registers an error handler that also receives the original error in
+// DefaultErrorRegistry.
+func RegisterErrorHandlerWithOriginal[E error](instance E, handler func(ctx context.Context, matched E, original error) (int, any), options ...RegistrationOption) {
+	RegisterErrorHandlerWithOriginalOn(DefaultErrorRegistry, instance, handler, options...)
+}
+
+// RegisterErrorHandlerWithOriginalOn registers an error handler in the given registry like RegisterErrorHandlerOn, but
+// the handler receives both the error that matched and the original error that is being resolved. This way a handler
+// for a sentinel can still use the message of the error that wraps it.
+func RegisterErrorHandlerWithOriginalOn[E error](registry *ErrorRegistry, instance E, handler func(ctx context.Context, matched E, original error) (int, any), options ...RegistrationOption) {
+	key, errorHandler := newInstanceHandler(instance, withoutHeaders(withMatchedAsOriginal(handler)), options)
+	errorHandler.handleOriginal = withOriginal(handler)
+
+	registry.addHandler(key, errorHandler)
+}
+
+// withOriginal turns a handler that receives the original error into the handleOriginal of an errorHandler. A nil
+// handler stays nil, so Validate can still report it.
+func withOriginal[E any](handler func(context.Context, E, error) (int, any)) func(context.Context, error, error) (int, any, http.Header) {
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, err error, original error) (int, any, http.Header) {
+		var errorOfType E
+
+		// This function should only be called if errorsAs succeeded, so this should never fail
+		_ = errorsAs(err, &errorOfType)
+
+		code, response := handler(ctx, errorOfType, original)
+
+		return code, response, nil
+	}
+}
+
+// withMatchedAsOriginal turns a handler that receives the original error into one that doesn't, by passing the
+// matched error as the original one. It's used if the original error isn't available, like in Handlers.
+func withMatchedAsOriginal[E error](handler func(context.Context, E, error) (int, any)) func(context.Context, E) (int, any) {
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, err E) (int, any) {
+		return handler(ctx, err, err)
+	}
+}
@@ -0,0 +1,93 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterErrorHandlerWithOriginalOn_PassesMatchedAndOriginalError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+
+	RegisterErrorHandlerWithOriginalOn(registry, errNotFound, func(_ context.Context, matched error, original error) (int, any) {
+		return http.StatusNotFound, matched.Error() + ": " + original.Error()
+	})
+
+	aErr := &AError{message: "invalid"}
+
+	RegisterErrorHandlerWithOriginalOn(registry, aErr, func(_ context.Context, matched *AError, original error) (int, any) {
+		return http.StatusBadRequest, matched.message + ": " + original.Error()
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"sentinel": {
+			err:              fmt.Errorf("order 42: %w", errNotFound),
+			expectedCode:     http.StatusNotFound,
+			expectedResponse: "not found: order 42: not found",
+		},
+		"type": {
+			err:              fmt.Errorf("validating: %w", aErr),
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "invalid: validating: invalid",
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterErrorHandlerWithOriginalOn_SkipsWithoutHandlerForVersion(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerWithOriginalOn(registry, &AError{}, func(context.Context, *AError, error) (int, any) {
+		return http.StatusTeapot, "v2"
+	}, ForAPIVersion("v2"))
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	v1Code, _ := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v1"), registry, &AError{})
+	v2Code, v2Response := NewErrorResponseFrom(WithAPIVersion(context.Background(), "v2"), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, http.StatusInternalServerError, v1Code)
+	assert.Equal(t, http.StatusTeapot, v2Code)
+	assert.Equal(t, "v2", v2Response)
+}
+
+func TestRegisterErrorHandlerWithOriginalOn_IsReportedByValidateIfNil(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerWithOriginalOn[*AError](registry, &AError{}, nil)
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.Error(t, err)
+}
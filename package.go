@@ -0,0 +1,41 @@
+package ginerr
+
+import (
+	"context"
+	"reflect"
+)
+
+// packageKey is the key of handlers registered for a package, see RegisterPackageHandlerOn
+type packageKey struct {
+	path string
+}
+
+func (p packageKey) Error() string {
+	return "package " + p.path
+}
+
+// RegisterPackageHandler registers a fallback error handler for errors of types in the given package in
+// DefaultErrorRegistry.
+func RegisterPackageHandler(packagePath string, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterPackageHandlerOn(DefaultErrorRegistry, packagePath, handler, options...)
+}
+
+// RegisterPackageHandlerOn registers a fallback error handler in the given registry for errors whose type is
+// defined in the package with the given import path, like "github.com/jackc/pgx/v5/pgconn", for policies like
+// "any database error is a 503". Pointer types are matched by the type they point to. The handler is only used if
+// no other handler matches any error in the tree, and receives the error from the package.
+func RegisterPackageHandlerOn(registry *ErrorRegistry, packagePath string, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	matches := func(err error) bool {
+		errorType := reflect.TypeOf(err)
+		for errorType.Kind() == reflect.Pointer {
+			errorType = errorType.Elem()
+		}
+
+		return errorType.PkgPath() == packagePath
+	}
+
+	errorHandler := newMatcherHandler(matches, handler, options)
+	errorHandler.fallback = true
+
+	registry.addHandler(packageKey{path: packagePath}, errorHandler)
+}
@@ -0,0 +1,113 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterPackageHandlerOn_IsUsedAsFallback(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterPackageHandlerOn(registry, "net/url", func(_ context.Context, err error) (int, any) {
+		return http.StatusServiceUnavailable, fmt.Sprintf("%T", err)
+	})
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "specific"
+	})
+
+	urlErr := &url.Error{Op: "Get", URL: "http://example.com", Err: assert.AnError}
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"package": {
+			err:              urlErr,
+			expectedCode:     http.StatusServiceUnavailable,
+			expectedResponse: "*url.Error",
+		},
+		"wrapped package": {
+			err:              fmt.Errorf("fetching: %w", urlErr),
+			expectedCode:     http.StatusServiceUnavailable,
+			expectedResponse: "*url.Error",
+		},
+		"value type": {
+			err:              url.EscapeError("%"),
+			expectedCode:     http.StatusServiceUnavailable,
+			expectedResponse: "url.EscapeError",
+		},
+		"specific match in tree": {
+			err:              errors.Join(urlErr, &AError{}),
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "specific",
+		},
+		"other package": {
+			err:          errors.New("other"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterPackageHandlerOn_IsLeftOutOfJoinStrategiesIfOthersMatch(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetJoinStrategy(JoinWorstStatus)
+
+	RegisterPackageHandlerOn(registry, "net/url", func(context.Context, error) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, errors.Join(&url.Error{Err: assert.AnError}, &AError{}))
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestRegisterPackageHandlerOn_IsEvaluatedLast(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterPackageHandlerOn(registry, "net/url", func(context.Context, error) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	}, WithPriority(10))
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	rules := registry.Rules()
+
+	// Assert
+	require.Len(t, rules, 2)
+	assert.False(t, rules[0].Fallback)
+	assert.True(t, rules[1].Fallback)
+	assert.Equal(t, "package net/url", ResolveFrom(context.Background(), registry, &url.Error{Err: assert.AnError}).HandlerName)
+}
@@ -0,0 +1,28 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+)
+
+// NewErrorRegistryWithParent instantiates a new ErrorRegistry like NewErrorRegistry, which falls through to the
+// handlers of the parent for errors none of its own handlers match, so services can override a shared base registry.
+// The parent is consulted before the status code, legacy resolver, strict mode and default handler of the registry,
+// which are used instead of those of the parent. Registrations added to the parent later are used as well.
+func NewErrorRegistryWithParent(parent *ErrorRegistry) *ErrorRegistry {
+	registry := NewErrorRegistry()
+	registry.parent = parent
+
+	return registry
+}
+
+// resolveParent calls the handler of the closest parent that matches the error, the boolean is false if none did.
+func (e *ErrorRegistry) resolveParent(ctx context.Context, err error) (int, any, http.Header, handlerMatch, bool) {
+	for parent := e.parent; parent != nil; parent = parent.parent {
+		if code, response, headers, match, ok := parent.resolve(ctx, err); ok {
+			return code, response, headers, match, true
+		}
+	}
+
+	return 0, nil, nil, handlerMatch{}, false
+}
@@ -0,0 +1,94 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewErrorRegistryWithParent_FallsThroughToParent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	base := NewErrorRegistry()
+	parent := NewErrorRegistryWithParent(base)
+	registry := NewErrorRegistryWithParent(parent)
+
+	registry.RegisterDefaultHandler(func(context.Context, error) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+
+	errNotFound := errors.New("not found")
+	errConflict := errors.New("conflict")
+
+	RegisterErrorHandlerOn(base, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, "base"
+	})
+	RegisterErrorHandlerOn(base, errConflict, func(context.Context, error) (int, any) {
+		return http.StatusConflict, "base"
+	})
+	RegisterTypeOn(parent, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "parent"
+	})
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusGone, "registry"
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"override": {
+			err:              errNotFound,
+			expectedCode:     http.StatusGone,
+			expectedResponse: "registry",
+		},
+		"parent": {
+			err:              &AError{},
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "parent",
+		},
+		"grandparent": {
+			err:              errConflict,
+			expectedCode:     http.StatusConflict,
+			expectedResponse: "base",
+		},
+		"default handler": {
+			err:          &BError{},
+			expectedCode: http.StatusServiceUnavailable,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestNewErrorRegistryWithParent_ReportsParentHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	parent := NewErrorRegistry()
+	registry := NewErrorRegistryWithParent(parent)
+
+	RegisterTypeOn(parent, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	result := ResolveFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, result.Code)
+	assert.Equal(t, "type *ginerr.AError", result.HandlerName)
+}
@@ -0,0 +1,74 @@
+package ginerr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrStatusNotAllowed is returned by the policies of ForbidStatuses.
+var ErrStatusNotAllowed = errors.New("status code is not allowed")
+
+// Policy encodes an API guideline that responses of handlers must follow, such as "handlers may not return
+// 200-399". It returns an error if the status code returned by the described handler violates the guideline.
+type Policy func(info HandlerInfo, status int) error
+
+// PolicyViolationError is passed to the default handler if a handler returned a response that violates a policy.
+type PolicyViolationError struct {
+	// Err is the error that was resolved
+	Err error
+
+	// Violation is the error returned by the policy
+	Violation error
+
+	// Info describes the handler that violated the policy
+	Info HandlerInfo
+
+	// Status is the status code the handler returned
+	Status int
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("handler for %v returned %d: %v: %v", e.Info.Type, e.Status, e.Violation, e.Err)
+}
+
+// Unwrap returns both the original error and the violation.
+func (e *PolicyViolationError) Unwrap() []error {
+	return []error{e.Err, e.Violation}
+}
+
+// RegisterPolicy registers a policy that is checked against every response of a matched handler. Since handlers
+// are functions, the status code is only known once they're called, so policies are enforced during resolution. A
+// response that violates a policy is discarded and the default handler is called with a PolicyViolationError.
+func (e *ErrorRegistry) RegisterPolicy(policy Policy) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.policies = append(e.policies, policy)
+}
+
+// checkPolicies returns a PolicyViolationError if the status code violates any of the policies.
+func (e *ErrorRegistry) checkPolicies(info HandlerInfo, status int, err error) error {
+	e.mu.RLock()
+	policies := e.policies
+	e.mu.RUnlock()
+
+	for _, policy := range policies {
+		if violation := policy(info, status); violation != nil {
+			return &PolicyViolationError{Err: err, Violation: violation, Info: info, Status: status}
+		}
+	}
+
+	return nil
+}
+
+// ForbidStatuses returns a policy that forbids handlers from returning a status code between minimum and
+// maximum, both inclusive.
+func ForbidStatuses(minimum int, maximum int) Policy {
+	return func(_ HandlerInfo, status int) error {
+		if status >= minimum && status <= maximum {
+			return fmt.Errorf("%w: %d", ErrStatusNotAllowed, status)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterPolicy_CallsDefaultHandlerOnViolation(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusOK, "all good"
+	})
+
+	var calledWithErr error
+	registry.RegisterDefaultHandler(func(_ context.Context, err error) (int, any) {
+		calledWithErr = err
+		return http.StatusInternalServerError, "default"
+	})
+
+	registry.RegisterPolicy(ForbidStatuses(200, 399))
+
+	err := &AError{message: "abc"}
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, err)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, "default", response)
+
+	var violation *PolicyViolationError
+	require.ErrorAs(t, calledWithErr, &violation)
+	assert.ErrorIs(t, calledWithErr, err)
+	assert.ErrorIs(t, calledWithErr, ErrStatusNotAllowed)
+	assert.Equal(t, http.StatusOK, violation.Status)
+	assert.Equal(t, reflect.TypeFor[*AError](), violation.Info.Type)
+}
+
+func TestRegisterPolicy_ReturnsResponseOnNoViolation(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "bad"
+	})
+
+	registry.RegisterPolicy(ForbidStatuses(200, 399))
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "bad", response)
+}
+
+func TestRegisterPolicy_ReceivesHandlerInfo(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	errAuth := errors.New("unauthorized")
+	errOther := errors.New("other")
+
+	callback := func(context.Context, error) (int, any) {
+		return http.StatusUnauthorized, nil
+	}
+
+	RegisterErrorHandlerOn(registry, errAuth, callback)
+	RegisterErrorHandlerOn(registry, errOther, callback)
+
+	// 401 may only come from errAuth
+	errNotAuth := errors.New("only errAuth may return 401")
+	registry.RegisterPolicy(func(info HandlerInfo, status int) error {
+		if status == http.StatusUnauthorized && !errors.Is(info.Error, errAuth) {
+			return errNotAuth
+		}
+
+		return nil
+	})
+
+	// Act
+	authCode, _ := NewErrorResponseFrom(context.Background(), registry, errAuth)
+	otherCode, _ := NewErrorResponseFrom(context.Background(), registry, errOther)
+
+	// Assert
+	assert.Equal(t, http.StatusUnauthorized, authCode)
+	assert.Equal(t, http.StatusInternalServerError, otherCode)
+}
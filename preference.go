@@ -0,0 +1,23 @@
+package ginerr
+
+// MatchPreference decides which error is used if several errors in a chain have a handler, see SetMatchPreference.
+type MatchPreference int
+
+const (
+	// PreferOutermost uses the handler of the outermost error that has one, which is the most recent wrapping. It's
+	// the default and works like errors.As.
+	PreferOutermost MatchPreference = iota
+
+	// PreferInnermost uses the handler of the innermost error that has one, which is the root cause. For error
+	// trees, that's the last error that has a handler in the depth-first order of errors.As.
+	PreferInnermost
+)
+
+// SetMatchPreference sets whether the outermost or innermost error with a handler is used when several registered
+// errors appear in one chain. It applies to JoinFirstMatch, the other join strategies use every match.
+func (e *ErrorRegistry) SetMatchPreference(preference MatchPreference) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.matchPreference = preference
+}
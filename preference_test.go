@@ -0,0 +1,70 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorRegistry_SetMatchPreference_ChangesWhichHandlerWins(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		preference   MatchPreference
+		expectedCode int
+	}{
+		"outermost": {
+			preference:   PreferOutermost,
+			expectedCode: http.StatusBadGateway,
+		},
+		"innermost": {
+			preference:   PreferInnermost,
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+			registry.SetMatchPreference(testData.preference)
+
+			RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+				return http.StatusNotFound, nil
+			})
+			RegisterTypeOn(registry, func(context.Context, *wrappingError) (int, any) {
+				return http.StatusBadGateway, nil
+			})
+
+			err := fmt.Errorf("handler: %w", &wrappingError{err: fmt.Errorf("repository: %w", &AError{})})
+
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
+
+func TestErrorRegistry_SetMatchPreference_UsesOnlyMatchWithInnermost(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetMatchPreference(PreferInnermost)
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	matched, _ := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", &AError{}))
+	unmatched, _ := NewErrorResponseFrom(context.Background(), registry, assert.AnError)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, matched)
+	assert.Equal(t, http.StatusInternalServerError, unmatched)
+}
@@ -0,0 +1,33 @@
+package ginerr
+
+import (
+	"context"
+	"regexp"
+)
+
+// RegisterRegexErrorHandler registers an error handler for errors whose message matches the pattern in
+// DefaultErrorRegistry.
+func RegisterRegexErrorHandler(pattern *regexp.Regexp, handler func(ctx context.Context, err error, groups []string) (int, any), options ...RegistrationOption) {
+	RegisterRegexErrorHandlerOn(DefaultErrorRegistry, pattern, handler, options...)
+}
+
+// RegisterRegexErrorHandlerOn registers an error handler in the given registry for errors whose message matches
+// the pattern, for libraries that embed IDs or codes in their error strings. The handler receives the first error
+// in the tree that matches and the submatches of the pattern, where groups[0] is the entire match like
+// regexp.FindStringSubmatch. It panics if the pattern is nil.
+func RegisterRegexErrorHandlerOn(registry *ErrorRegistry, pattern *regexp.Regexp, handler func(ctx context.Context, err error, groups []string) (int, any), options ...RegistrationOption) {
+	if pattern == nil {
+		panic("ginerr: can't register a handler for a nil pattern")
+	}
+
+	var matchHandler func(context.Context, error) (int, any)
+	if handler != nil {
+		matchHandler = func(ctx context.Context, err error) (int, any) {
+			return handler(ctx, err, pattern.FindStringSubmatch(err.Error()))
+		}
+	}
+
+	RegisterMatcherHandlerOn(registry, func(err error) bool {
+		return pattern.MatchString(err.Error())
+	}, matchHandler, options...)
+}
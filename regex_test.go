@@ -0,0 +1,65 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterRegexErrorHandlerOn_PassesCaptureGroups(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var calledWith []string
+	RegisterRegexErrorHandlerOn(registry, regexp.MustCompile(`account (\w+) not found`), func(_ context.Context, _ error, groups []string) (int, any) {
+		calledWith = groups
+
+		return http.StatusNotFound, "unknown account " + groups[1]
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("transfer: %w", errors.New("account NL01 not found")))
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "unknown account NL01", response)
+	assert.Equal(t, []string{"account NL01 not found", "NL01"}, calledWith)
+}
+
+func TestRegisterRegexErrorHandlerOn_FallsBackOnNoMatch(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterRegexErrorHandlerOn(registry, regexp.MustCompile(`^account \w+ not found$`), func(context.Context, error, []string) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	code, _ := NewErrorResponseFrom(context.Background(), registry, errors.New("account lookup failed"))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestRegisterRegexErrorHandlerOn_PanicsOnNilPattern(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := func() {
+		RegisterRegexErrorHandlerOn(registry, nil, func(context.Context, error, []string) (int, any) {
+			return http.StatusNotFound, nil
+		})
+	}
+
+	// Assert
+	assert.PanicsWithValue(t, "ginerr: can't register a handler for a nil pattern", result)
+}
@@ -0,0 +1,69 @@
+package ginerr
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// registryContextKey is the context key under which the registry is stored
+type registryContextKey struct{}
+
+// registryGinKey is the key under which the registry is stored in gin contexts, which only support string keys
+const registryGinKey = "github.com/ing-bank/ginerr/v3.registry"
+
+// ContextWithRegistry returns a copy of the context with the registry attached, functions that don't take a
+// registry, like NewErrorResponse, will use it instead of the DefaultErrorRegistry.
+func ContextWithRegistry(ctx context.Context, registry *ErrorRegistry) context.Context {
+	return context.WithValue(ctx, registryContextKey{}, registry)
+}
+
+// WithRegistry returns a gin middleware that attaches the registry to the requests of a route group, so
+// different route groups can use different registries.
+func WithRegistry(registry *ErrorRegistry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(registryGinKey, registry)
+		c.Request = c.Request.WithContext(ContextWithRegistry(c.Request.Context(), registry))
+
+		c.Next()
+	}
+}
+
+// Attach attaches the registry to all requests of the engine, like WithRegistry. Middleware only applies to routes
+// that are registered after it, so Attach should be called before any routes are added.
+func Attach(engine *gin.Engine, registry *ErrorRegistry) {
+	engine.Use(WithRegistry(registry))
+}
+
+// FromContext returns the registry attached to the context by Attach, WithRegistry or ContextWithRegistry, so
+// application code and third-party middleware don't need to import a global. If no registry is attached, the
+// DefaultErrorRegistry is returned.
+func FromContext(ctx context.Context) *ErrorRegistry {
+	return registryFromContext(ctx)
+}
+
+// registryFromContext returns the registry attached to the context, or the DefaultErrorRegistry if there is none.
+func registryFromContext(ctx context.Context) *ErrorRegistry {
+	if ctx == nil {
+		return DefaultErrorRegistry
+	}
+
+	// Without ContextWithFallback enabled, gin contexts don't look in the request context
+	if c, ok := ctx.(*gin.Context); ok {
+		if registry, ok := c.Value(registryGinKey).(*ErrorRegistry); ok {
+			return registry
+		}
+
+		if c.Request == nil {
+			return DefaultErrorRegistry
+		}
+
+		ctx = c.Request.Context()
+	}
+
+	if registry, ok := ctx.Value(registryContextKey{}).(*ErrorRegistry); ok {
+		return registry
+	}
+
+	return DefaultErrorRegistry
+}
@@ -0,0 +1,147 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewErrorResponse_UsesRegistryFromContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusTeapot, "from context"
+	})
+
+	ctx := ContextWithRegistry(context.Background(), registry)
+
+	// Act
+	code, response := NewErrorResponse(ctx, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusTeapot, code)
+	assert.Equal(t, "from context", response)
+}
+
+func TestWithRegistry_AttachesRegistryToRouteGroups(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	adminRegistry := NewErrorRegistry()
+	RegisterErrorHandlerOn(adminRegistry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, "admin: " + err.message
+	})
+
+	publicRegistry := NewErrorRegistry()
+	RegisterErrorHandlerOn(publicRegistry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "public"
+	})
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+
+	handler := WrapHandler(func(*gin.Context) error {
+		return &AError{message: "details"}
+	})
+
+	engine.Group("/admin", WithRegistry(adminRegistry)).GET("", handler)
+	engine.Group("/public", WithRegistry(publicRegistry)).GET("", handler)
+
+	// Request context, for handlers that pass it on instead of the gin context
+	engine.Group("/request", WithRegistry(adminRegistry)).GET("", func(c *gin.Context) {
+		code, response := NewErrorResponse(c.Request.Context(), &AError{message: "request"})
+		c.JSON(code, response)
+	})
+
+	tests := map[string]string{
+		"/admin":   `"admin: details"`,
+		"/public":  `"public"`,
+		"/request": `"admin: request"`,
+	}
+
+	for path, expected := range tests {
+		t.Run(path, func(t *testing.T) {
+			t.Parallel()
+			recorder := httptest.NewRecorder()
+
+			// Act
+			engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, http.NoBody))
+
+			// Assert
+			assert.Equal(t, http.StatusBadRequest, recorder.Code)
+			assert.JSONEq(t, expected, recorder.Body.String())
+		})
+	}
+}
+
+func TestAbortWithError_UsesRegistryFromContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, "conflict"
+	})
+
+	engine := newTestEngine(WithRegistry(registry), func(c *gin.Context) {
+		AbortWithError(c, &AError{})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.JSONEq(t, `"conflict"`, recorder.Body.String())
+}
+
+func TestRegistryFromContext_ReturnsDefaultErrorRegistryOnNoRegistry(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	// Act
+	result := registryFromContext(c)
+	resultBackground := registryFromContext(context.Background())
+
+	// Assert
+	assert.Same(t, DefaultErrorRegistry, result)
+	assert.Same(t, DefaultErrorRegistry, resultBackground)
+}
+
+func TestAttach_AttachesRegistryToEngine(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	Attach(engine, registry)
+
+	var result *ErrorRegistry
+
+	engine.GET("/", func(c *gin.Context) {
+		result = FromContext(c)
+	})
+
+	// Act
+	engine.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", http.NoBody))
+
+	// Assert
+	assert.Same(t, registry, result)
+}
+
+func TestFromContext_ReturnsDefaultErrorRegistryOnNoRegistry(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := FromContext(context.Background())
+
+	// Assert
+	assert.Same(t, DefaultErrorRegistry, result)
+}
@@ -0,0 +1,153 @@
+package ginerr
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// RemoteErrorHeader is the header in which SetRemoteErrorHeader stores a RemoteError.
+const RemoteErrorHeader = "X-Ginerr-Error"
+
+// RemoteError is a serializable version of a resolved error, it's used to pass error semantics between
+// internal services. The calling service can turn it back into one of its own errors using HydrateRemoteErrorFrom.
+type RemoteError struct {
+	// Status is the status code that the error was resolved to
+	Status int `json:"status"`
+
+	// Code is the machine-readable code of the error, taken from errors that implement `Code() string`
+	Code string `json:"code,omitempty"`
+
+	// Message is the response of the handler if it was a string
+	Message string `json:"message,omitempty"`
+
+	// Details is the response of the handler if it was anything but a string
+	Details json.RawMessage `json:"details,omitempty"`
+
+	// err is the local error this remote error was hydrated into
+	err error
+}
+
+func (e *RemoteError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("remote error %d: %s", e.Status, e.Message)
+	}
+
+	return fmt.Sprintf("remote error %d", e.Status)
+}
+
+// Unwrap returns the local error a remote error was hydrated into, if any.
+func (e *RemoteError) Unwrap() error {
+	return e.err
+}
+
+// codeError is implemented by errors that have a machine-readable code
+type codeError interface {
+	error
+	Code() string
+}
+
+// NewRemoteError resolves the error using the registry attached to the context or the DefaultErrorRegistry and turns
+// the result into a RemoteError.
+func NewRemoteError(ctx context.Context, err error) (*RemoteError, error) {
+	return NewRemoteErrorFrom(ctx, registryFromContext(ctx), err)
+}
+
+// NewRemoteErrorFrom resolves the error using the given registry and turns the result into a RemoteError. An error
+// is returned if the response of the handler could not be marshalled to JSON.
+func NewRemoteErrorFrom[E error](ctx context.Context, registry *ErrorRegistry, err E) (*RemoteError, error) {
+	code, response := NewErrorResponseFrom(ctx, registry, err)
+
+	remote := &RemoteError{Status: code}
+
+	var errWithCode codeError
+	if errorsAs(err, &errWithCode) {
+		remote.Code = errWithCode.Code()
+	}
+
+	switch typedResponse := response.(type) {
+	case nil:
+	case string:
+		remote.Message = typedResponse
+	default:
+		details, marshalErr := json.Marshal(response)
+		if marshalErr != nil {
+			return nil, fmt.Errorf("failed to marshal response: %w", marshalErr)
+		}
+
+		remote.Details = details
+	}
+
+	return remote, nil
+}
+
+// SetRemoteErrorHeader stores the remote error in the RemoteErrorHeader of the given headers.
+func SetRemoteErrorHeader(header http.Header, remote *RemoteError) error {
+	result, err := json.Marshal(remote)
+	if err != nil {
+		return fmt.Errorf("failed to marshal remote error: %w", err)
+	}
+
+	header.Set(RemoteErrorHeader, base64.RawURLEncoding.EncodeToString(result))
+
+	return nil
+}
+
+// RemoteErrorFromHeader reads a remote error from the RemoteErrorHeader of the given headers, it returns nil
+// if the header is not present.
+func RemoteErrorFromHeader(header http.Header) (*RemoteError, error) {
+	value := header.Get(RemoteErrorHeader)
+	if value == "" {
+		return nil, nil //nolint:nilnil // No header is not an error
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode remote error: %w", err)
+	}
+
+	remote := &RemoteError{}
+	if err := json.Unmarshal(decoded, remote); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal remote error: %w", err)
+	}
+
+	return remote, nil
+}
+
+// RegisterRemoteError registers the local error a remote error code is hydrated into in DefaultErrorRegistry.
+func RegisterRemoteError(code string, err error) {
+	RegisterRemoteErrorOn(DefaultErrorRegistry, code, err)
+}
+
+// RegisterRemoteErrorOn registers the local error a remote error code is hydrated into in the given registry.
+func RegisterRemoteErrorOn(registry *ErrorRegistry, code string, err error) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.remoteErrors[code] = err
+}
+
+// HydrateRemoteError turns a remote error into a local error using the DefaultErrorRegistry.
+func HydrateRemoteError(remote *RemoteError) error {
+	return HydrateRemoteErrorFrom(DefaultErrorRegistry, remote)
+}
+
+// HydrateRemoteErrorFrom turns a remote error into a local error using the errors registered with
+// RegisterRemoteErrorOn. The returned remote error wraps the local error, so resolving it matches the handlers
+// of the local error. If the code is unknown, the returned remote error does not wrap anything. A nil remote error,
+// like the one RemoteErrorFromHeader returns if there was no header, returns nil.
+func HydrateRemoteErrorFrom(registry *ErrorRegistry, remote *RemoteError) error {
+	if remote == nil {
+		return nil
+	}
+
+	registry.mu.RLock()
+	defer registry.mu.RUnlock()
+
+	hydrated := *remote
+	hydrated.err = registry.remoteErrors[remote.Code]
+
+	return &hydrated
+}
@@ -0,0 +1,174 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type codedError struct {
+	code string
+}
+
+func (e *codedError) Error() string {
+	return "coded error"
+}
+
+func (e *codedError) Code() string {
+	return e.code
+}
+
+func TestNewRemoteErrorFrom_ReturnsStringResponseAsMessage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &codedError{}, func(context.Context, *codedError) (int, any) {
+		return http.StatusNotFound, "order not found"
+	})
+
+	err := fmt.Errorf("failed: %w", &codedError{code: "ORDER_NOT_FOUND"})
+
+	// Act
+	remote, remoteErr := NewRemoteErrorFrom(context.Background(), registry, err)
+
+	// Assert
+	require.NoError(t, remoteErr)
+	assert.Equal(t, &RemoteError{Status: http.StatusNotFound, Code: "ORDER_NOT_FOUND", Message: "order not found"}, remote)
+}
+
+func TestNewRemoteErrorFrom_ReturnsOtherResponsesAsDetails(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, map[string]string{"field": "amount"}
+	})
+
+	// Act
+	remote, err := NewRemoteErrorFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, remote.Status)
+	assert.Empty(t, remote.Code)
+	assert.JSONEq(t, `{"field":"amount"}`, string(remote.Details))
+}
+
+func TestNewRemoteErrorFrom_ReturnsErrorOnUnmarshallableResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, make(chan int)
+	})
+
+	// Act
+	remote, err := NewRemoteErrorFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Nil(t, remote)
+	assert.Error(t, err)
+}
+
+func TestRemoteErrorFromHeader_ReturnsRemoteErrorFromSetRemoteErrorHeader(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	header := http.Header{}
+	remote := &RemoteError{Status: http.StatusConflict, Code: "CONFLICT", Message: "conflict", Details: []byte(`{"a":"b"}`)}
+
+	// Act
+	setErr := SetRemoteErrorHeader(header, remote)
+	result, err := RemoteErrorFromHeader(header)
+
+	// Assert
+	require.NoError(t, setErr)
+	require.NoError(t, err)
+	assert.Equal(t, remote, result)
+}
+
+func TestRemoteErrorFromHeader_ReturnsNilOnNoHeader(t *testing.T) {
+	t.Parallel()
+	// Act
+	result, err := RemoteErrorFromHeader(http.Header{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestRemoteErrorFromHeader_ReturnsErrorOnInvalidHeader(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	header := http.Header{}
+	header.Set(RemoteErrorHeader, "%%%")
+
+	// Act
+	result, err := RemoteErrorFromHeader(header)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Error(t, err)
+}
+
+func TestHydrateRemoteErrorFrom_ReturnsRegisteredError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	errOrderNotFound := errors.New("order not found")
+
+	RegisterRemoteErrorOn(registry, "ORDER_NOT_FOUND", errOrderNotFound)
+	RegisterErrorHandlerOn(registry, errOrderNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, "not found"
+	})
+
+	remote := &RemoteError{Status: http.StatusNotFound, Code: "ORDER_NOT_FOUND"}
+
+	// Act
+	err := HydrateRemoteErrorFrom(registry, remote)
+
+	// Assert
+	require.ErrorIs(t, err, errOrderNotFound)
+
+	var remoteErr *RemoteError
+	require.ErrorAs(t, err, &remoteErr)
+	assert.Equal(t, "ORDER_NOT_FOUND", remoteErr.Code)
+
+	code, response := NewErrorResponseFrom(context.Background(), registry, err)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "not found", response)
+}
+
+func TestHydrateRemoteErrorFrom_ReturnsRemoteErrorOnUnknownCode(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	remote := &RemoteError{Status: http.StatusNotFound, Code: "UNKNOWN"}
+
+	// Act
+	err := HydrateRemoteErrorFrom(registry, remote)
+
+	// Assert
+	assert.Equal(t, remote, err)
+	assert.NoError(t, errors.Unwrap(err))
+}
+
+func TestHydrateRemoteErrorFrom_ReturnsNilOnNilRemoteError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	remote, err := RemoteErrorFromHeader(http.Header{})
+	require.NoError(t, err)
+
+	// Act
+	result := HydrateRemoteErrorFrom(NewErrorRegistry(), remote)
+
+	// Assert
+	assert.NoError(t, result)
+}
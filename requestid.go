@@ -0,0 +1,70 @@
+package ginerr
+
+import "github.com/gin-gonic/gin"
+
+// RequestIDSource returns the ID of a gin request, or an empty string if it has none.
+type RequestIDSource func(c *gin.Context) string
+
+// RequestIDFromHeader returns a RequestIDSource that reads the request ID from the given request header,
+// like X-Request-ID.
+func RequestIDFromHeader(header string) RequestIDSource {
+	return func(c *gin.Context) string {
+		if c.Request == nil {
+			return ""
+		}
+
+		return c.Request.Header.Get(header)
+	}
+}
+
+// RequestIDFromContextKey returns a RequestIDSource that reads the request ID from the given key, which is
+// looked up in the gin context first and in the request context second.
+func RequestIDFromContextKey(key any) RequestIDSource {
+	return func(c *gin.Context) string {
+		if requestID, ok := c.Value(key).(string); ok {
+			return requestID
+		}
+
+		if c.Request == nil {
+			return ""
+		}
+
+		requestID, _ := c.Request.Context().Value(key).(string)
+
+		return requestID
+	}
+}
+
+// requestIDResponse is implemented by responses that can carry a request ID, like respond.Error
+type requestIDResponse interface {
+	WithRequestID(requestID string) any
+}
+
+// SetRequestIDSource makes the gin helpers (AbortWithError, Respond, WrapHandler and Middleware) inject the request
+// ID into responses that implement `WithRequestID(requestID string) any`, like respond.Error, so users can quote
+// it to support without every handler doing it. Nil disables the injection.
+func (e *ErrorRegistry) SetRequestIDSource(source RequestIDSource) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.requestIDSource = source
+}
+
+// injectRequestID sets the request ID on the response if it supports it and a source was set.
+func (e *ErrorRegistry) injectRequestID(c *gin.Context, response any) any {
+	e.mu.RLock()
+	source := e.requestIDSource
+	e.mu.RUnlock()
+
+	typedResponse, ok := response.(requestIDResponse)
+	if source == nil || !ok {
+		return response
+	}
+
+	requestID := source(c)
+	if requestID == "" {
+		return response
+	}
+
+	return typedResponse.WithRequestID(requestID)
+}
@@ -0,0 +1,109 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ing-bank/ginerr/v3/respond"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorRegistry_SetRequestIDSource_InjectsRequestIDFromHeader(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetRequestIDSource(RequestIDFromHeader("X-Request-ID"))
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return respond.NotFound("order not found")
+	})
+
+	engine := newTestEngine(WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{}
+	}))
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	request.Header.Set("X-Request-ID", "abc-123")
+
+	// Act
+	engine.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.JSONEq(t, `{"message":"order not found","requestId":"abc-123"}`, recorder.Body.String())
+}
+
+func TestErrorRegistry_SetRequestIDSource_InjectsRequestIDFromContextKey(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetRequestIDSource(RequestIDFromContextKey(dummyContextKey("requestID")))
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return respond.Conflict("conflict")
+	})
+
+	engine := newTestEngine(func(c *gin.Context) {
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), dummyContextKey("requestID"), "abc-123"))
+	}, WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{}
+	}))
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.JSONEq(t, `{"message":"conflict","requestId":"abc-123"}`, recorder.Body.String())
+}
+
+func TestErrorRegistry_SetRequestIDSource_IgnoresOtherResponses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetRequestIDSource(RequestIDFromHeader("X-Request-ID"))
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "plain"
+	})
+
+	engine := newTestEngine(WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{}
+	}))
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	request.Header.Set("X-Request-ID", "abc-123")
+
+	// Act
+	engine.ServeHTTP(recorder, request)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.JSONEq(t, `"plain"`, recorder.Body.String())
+}
+
+func TestErrorRegistry_SetRequestIDSource_IgnoresMissingRequestID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetRequestIDSource(RequestIDFromHeader("X-Request-ID"))
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return respond.NotFound("order not found")
+	})
+
+	engine := newTestEngine(WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{}
+	}))
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.JSONEq(t, `{"message":"order not found"}`, recorder.Body.String())
+}
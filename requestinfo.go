@@ -0,0 +1,41 @@
+package ginerr
+
+import "context"
+
+// RequestInfo describes the request an error is resolved for.
+type RequestInfo struct {
+	// Method is the HTTP method of the request, like POST
+	Method string
+
+	// Route is the matched route pattern, like /orders/:id, it's empty if no route matched
+	Route string
+}
+
+// String returns the request info as "POST /orders/:id".
+func (r RequestInfo) String() string {
+	return r.Method + " " + r.Route
+}
+
+// requestInfoContextKey is the context key under which the request info is stored
+type requestInfoContextKey struct{}
+
+// WithRequestInfo returns a copy of the context with the given request info, for requests that aren't served
+// by gin. Error handlers can retrieve it using RequestInfoFromContext.
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoContextKey{}, info)
+}
+
+// RequestInfoFromContext returns the request info set by WithRequestInfo. For contexts that belong to a gin
+// request, it's taken from the gin context instead. The boolean is false if no request info is available.
+func RequestInfoFromContext(ctx context.Context) (RequestInfo, bool) {
+	if info, ok := ctx.Value(requestInfoContextKey{}).(RequestInfo); ok {
+		return info, true
+	}
+
+	c := ginContextFrom(ctx)
+	if c == nil || c.Request == nil {
+		return RequestInfo{}, false
+	}
+
+	return RequestInfo{Method: c.Request.Method, Route: c.FullPath()}, true
+}
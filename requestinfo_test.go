@@ -0,0 +1,74 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestInfoFromContext_ReturnsRequestInfo(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := WithRequestInfo(context.Background(), RequestInfo{Method: http.MethodPost, Route: "/orders/{id}"})
+
+	// Act
+	result, ok := RequestInfoFromContext(ctx)
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, RequestInfo{Method: http.MethodPost, Route: "/orders/{id}"}, result)
+}
+
+func TestRequestInfoFromContext_ReturnsFalseOnNoRequestInfo(t *testing.T) {
+	t.Parallel()
+	// Act
+	result, ok := RequestInfoFromContext(context.Background())
+
+	// Assert
+	assert.False(t, ok)
+	assert.Empty(t, result)
+}
+
+func TestRequestInfoFromContext_ReturnsGinRoute(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(ctx context.Context, _ *AError) (int, any) {
+		info, _ := RequestInfoFromContext(ctx)
+
+		return http.StatusNotFound, info.String()
+	})
+
+	gin.SetMode(gin.TestMode)
+
+	engine := gin.New()
+	engine.POST("/orders/:id", WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{}
+	}))
+
+	recorder := httptest.NewRecorder()
+
+	// Act
+	engine.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/orders/123", http.NoBody))
+
+	// Assert
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.JSONEq(t, `"POST /orders/:id"`, recorder.Body.String())
+}
+
+func TestRequestInfo_String_ReturnsMethodAndRoute(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	info := RequestInfo{Method: http.MethodGet, Route: "/users/:id"}
+
+	// Act
+	result := info.String()
+
+	// Assert
+	assert.Equal(t, "GET /users/:id", result)
+}
@@ -0,0 +1,34 @@
+package respond_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ing-bank/ginerr/v3"
+	"github.com/ing-bank/ginerr/v3/respond"
+)
+
+type OrderNotFoundError struct {
+	ID string
+}
+
+func (e *OrderNotFoundError) Error() string {
+	return "order not found: " + e.ID
+}
+
+func ExampleNotFound() {
+	registry := ginerr.NewErrorRegistry()
+
+	// Use the builders in your error handlers
+	ginerr.RegisterErrorHandlerOn(registry, &OrderNotFoundError{}, func(_ context.Context, err *OrderNotFoundError) (int, any) {
+		return respond.NotFound("order %s does not exist", err.ID)
+	})
+
+	code, response := ginerr.NewErrorResponseFrom(context.Background(), registry, &OrderNotFoundError{ID: "abc"})
+
+	// Check the output
+	fmt.Printf("%d: %s\n", code, response.(*respond.Error).Message)
+
+	// Output:
+	// 404: order abc does not exist
+}
@@ -0,0 +1,92 @@
+// Package respond contains builders for the response bodies of error handlers, so that handlers across teams
+// return consistent bodies. Every builder returns the (int, any) tuple error handlers are expected to return.
+package respond
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Error is the standard response body of error handlers.
+type Error struct {
+	// Message is a user-friendly description of the error
+	Message string `json:"message"`
+
+	// Fields contains the fields that were invalid, if any
+	Fields []Field `json:"fields,omitempty"`
+
+	// RequestID is the ID of the request that failed, so users can quote it to support
+	RequestID string `json:"requestId,omitempty"`
+}
+
+// WithRequestID returns a copy of the error with the request ID set, the original is not modified as handlers
+// might return the same error for every request.
+func (e Error) WithRequestID(requestID string) any {
+	e.RequestID = requestID
+
+	return &e
+}
+
+// Field describes a single invalid field of the input.
+type Field struct {
+	// Name is the name of the field, e.g. "amount"
+	Name string `json:"name"`
+
+	// Message describes what is wrong with the field, e.g. "must be positive"
+	Message string `json:"message"`
+}
+
+// Status returns the given status code and an Error with a formatted message.
+func Status(code int, format string, args ...any) (int, any) {
+	return code, &Error{Message: fmt.Sprintf(format, args...)}
+}
+
+// BadRequest returns a 400 Bad Request response with a formatted message.
+func BadRequest(format string, args ...any) (int, any) {
+	return Status(http.StatusBadRequest, format, args...)
+}
+
+// Unauthorized returns a 401 Unauthorized response with a formatted message.
+func Unauthorized(format string, args ...any) (int, any) {
+	return Status(http.StatusUnauthorized, format, args...)
+}
+
+// Forbidden returns a 403 Forbidden response with a formatted message.
+func Forbidden(format string, args ...any) (int, any) {
+	return Status(http.StatusForbidden, format, args...)
+}
+
+// NotFound returns a 404 Not Found response with a formatted message.
+func NotFound(format string, args ...any) (int, any) {
+	return Status(http.StatusNotFound, format, args...)
+}
+
+// Conflict returns a 409 Conflict response with a formatted message.
+func Conflict(format string, args ...any) (int, any) {
+	return Status(http.StatusConflict, format, args...)
+}
+
+// TooManyRequests returns a 429 Too Many Requests response with a formatted message.
+func TooManyRequests(format string, args ...any) (int, any) {
+	return Status(http.StatusTooManyRequests, format, args...)
+}
+
+// InternalServerError returns a 500 Internal Server Error response with a formatted message.
+func InternalServerError(format string, args ...any) (int, any) {
+	return Status(http.StatusInternalServerError, format, args...)
+}
+
+// ServiceUnavailable returns a 503 Service Unavailable response with a formatted message.
+func ServiceUnavailable(format string, args ...any) (int, any) {
+	return Status(http.StatusServiceUnavailable, format, args...)
+}
+
+// FieldError returns a 400 Bad Request response for a single invalid field.
+func FieldError(name string, message string) (int, any) {
+	return FieldErrors(Field{Name: name, Message: message})
+}
+
+// FieldErrors returns a 400 Bad Request response for one or more invalid fields.
+func FieldErrors(fields ...Field) (int, any) {
+	return http.StatusBadRequest, &Error{Message: "invalid input", Fields: fields}
+}
@@ -0,0 +1,80 @@
+package respond
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus_ReturnsFormattedError(t *testing.T) {
+	t.Parallel()
+	// Act
+	code, response := Status(http.StatusTeapot, "order %s is %d degrees", "abc", 90)
+
+	// Assert
+	assert.Equal(t, http.StatusTeapot, code)
+	assert.Equal(t, &Error{Message: "order abc is 90 degrees"}, response)
+}
+
+func TestBuilders_ReturnExpectedStatusCodes(t *testing.T) {
+	t.Parallel()
+	tests := map[int]func(string, ...any) (int, any){
+		http.StatusBadRequest:          BadRequest,
+		http.StatusUnauthorized:        Unauthorized,
+		http.StatusForbidden:           Forbidden,
+		http.StatusNotFound:            NotFound,
+		http.StatusConflict:            Conflict,
+		http.StatusTooManyRequests:     TooManyRequests,
+		http.StatusInternalServerError: InternalServerError,
+		http.StatusServiceUnavailable:  ServiceUnavailable,
+	}
+
+	for expectedCode, builder := range tests {
+		t.Run(http.StatusText(expectedCode), func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := builder("order %s", "abc")
+
+			// Assert
+			assert.Equal(t, expectedCode, code)
+			assert.Equal(t, &Error{Message: "order abc"}, response)
+		})
+	}
+}
+
+func TestFieldError_ReturnsBadRequestWithField(t *testing.T) {
+	t.Parallel()
+	// Act
+	code, response := FieldError("amount", "must be positive")
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, &Error{Message: "invalid input", Fields: []Field{{Name: "amount", Message: "must be positive"}}}, response)
+}
+
+func TestFieldErrors_ReturnsBadRequestWithFields(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	fields := []Field{{Name: "amount", Message: "must be positive"}, {Name: "currency", Message: "is required"}}
+
+	// Act
+	code, response := FieldErrors(fields...)
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, &Error{Message: "invalid input", Fields: fields}, response)
+}
+
+func TestError_WithRequestID_ReturnsCopyWithRequestID(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	original := &Error{Message: "not found"}
+
+	// Act
+	result := original.WithRequestID("abc-123")
+
+	// Assert
+	assert.Equal(t, &Error{Message: "not found", RequestID: "abc-123"}, result)
+	assert.Empty(t, original.RequestID)
+}
@@ -0,0 +1,61 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// defaultHandlerName is the HandlerName of responses of the default handler
+	defaultHandlerName = "default"
+
+	// legacyHandlerName is the HandlerName of responses of the legacy resolver, see SetLegacyResolver
+	legacyHandlerName = "legacy"
+
+	// unmappedHandlerName is the HandlerName of responses to unmapped errors in strict mode, see SetStrictMode
+	unmappedHandlerName = "unmapped"
+)
+
+// ErrorResponse is the response an error resolves into, see ResolveFrom.
+type ErrorResponse struct {
+	// Code is the HTTP status code
+	Code int
+
+	// Body is the response body returned by the handler, nil if it has none
+	Body any
+
+	// Headers are the headers set by the handler, nil if it didn't set any
+	Headers http.Header
+
+	// CodeString is the status code as a string, like "404", for metric labels and log fields
+	CodeString string
+
+	// HandlerName describes the registration that resolved the error, like `type *pkg.NotFoundError`, or
+	// "nil", "aggregate", "status code", "legacy", "unmapped" or "default" if no handler matched
+	HandlerName string
+}
+
+// newErrorResponse creates an ErrorResponse.
+func newErrorResponse(code int, body any, headers http.Header, handlerName string) ErrorResponse {
+	return ErrorResponse{
+		Code:        code,
+		Body:        body,
+		Headers:     headers,
+		CodeString:  strconv.Itoa(code),
+		HandlerName: handlerName,
+	}
+}
+
+// Resolve resolves the error into an ErrorResponse using the registry attached to the context (see
+// ContextWithRegistry and WithRegistry) or the DefaultErrorRegistry.
+func Resolve(ctx context.Context, err error) ErrorResponse {
+	return ResolveFrom(ctx, registryFromContext(ctx), err)
+}
+
+// ResolveFrom resolves the error into an ErrorResponse using the given registry. It's like
+// NewErrorResponseWithHeadersFrom, but returns a struct so more information can be added without changing the
+// signature.
+func ResolveFrom[E error](ctx context.Context, registry *ErrorRegistry, err E) ErrorResponse {
+	return registry.resolveResponse(ctx, err)
+}
@@ -0,0 +1,126 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveFrom_ReturnsErrorResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errUnavailable := errors.New("unavailable")
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		return http.StatusBadRequest, err.message
+	})
+	RegisterErrorHandlerWithHeadersOn(registry, errUnavailable, func(context.Context, error) (int, any, http.Header) {
+		return http.StatusServiceUnavailable, nil, RetryAfterHeader(time.Minute)
+	})
+
+	tests := map[string]struct {
+		err      error
+		expected ErrorResponse
+	}{
+		"type": {
+			err: &AError{message: "invalid"},
+			expected: ErrorResponse{
+				Code:        http.StatusBadRequest,
+				Body:        "invalid",
+				CodeString:  "400",
+				HandlerName: "type *ginerr.AError",
+			},
+		},
+		"string error": {
+			err: errUnavailable,
+			expected: ErrorResponse{
+				Code:        http.StatusServiceUnavailable,
+				Headers:     RetryAfterHeader(time.Minute),
+				CodeString:  "503",
+				HandlerName: `error "unavailable"`,
+			},
+		},
+		"default": {
+			err: assert.AnError,
+			expected: ErrorResponse{
+				Code:        http.StatusInternalServerError,
+				CodeString:  "500",
+				HandlerName: "default",
+			},
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := ResolveFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expected, result)
+		})
+	}
+}
+
+func TestResolveFrom_NamesFallbacks(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		configure    func(registry *ErrorRegistry)
+		expectedName string
+	}{
+		"legacy": {
+			configure: func(registry *ErrorRegistry) {
+				registry.SetLegacyResolver(func(error) (int, any, bool) {
+					return http.StatusBadRequest, nil, true
+				})
+			},
+			expectedName: "legacy",
+		},
+		"unmapped": {
+			configure: func(registry *ErrorRegistry) {
+				registry.SetStrictMode(true)
+			},
+			expectedName: "unmapped",
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+			testData.configure(registry)
+
+			// Act
+			result := ResolveFrom(context.Background(), registry, assert.AnError)
+
+			// Assert
+			assert.Equal(t, testData.expectedName, result.HandlerName)
+		})
+	}
+}
+
+func TestResolve_UsesRegistryFromContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusTeapot, nil
+	})
+
+	ctx := ContextWithRegistry(context.Background(), registry)
+
+	// Act
+	result := Resolve(ctx, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusTeapot, result.Code)
+	assert.Equal(t, "418", result.CodeString)
+}
@@ -0,0 +1,89 @@
+package ginerr
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryAfterStrategy computes how long a client has to wait before retrying, used for 429 and 503 responses.
+// Using a strategy keeps the Retry-After of handlers in sync with the actual backoff policy.
+type RetryAfterStrategy interface {
+	RetryAfter(ctx context.Context, err error) time.Duration
+}
+
+// RetryAfterFunc allows ordinary functions to be used as a RetryAfterStrategy.
+type RetryAfterFunc func(ctx context.Context, err error) time.Duration
+
+func (f RetryAfterFunc) RetryAfter(ctx context.Context, err error) time.Duration {
+	return f(ctx, err)
+}
+
+// FixedRetryAfter always returns the given duration.
+func FixedRetryAfter(duration time.Duration) RetryAfterStrategy {
+	return RetryAfterFunc(func(context.Context, error) time.Duration {
+		return duration
+	})
+}
+
+// ExponentialRetryAfter doubles the base duration for every attempt, the attempt is retrieved using
+// AttemptFromContext. The result will never exceed maximum.
+func ExponentialRetryAfter(base time.Duration, maximum time.Duration) RetryAfterStrategy {
+	return RetryAfterFunc(func(ctx context.Context, _ error) time.Duration {
+		result := float64(base) * math.Pow(2, float64(AttemptFromContext(ctx)))
+
+		if result > float64(maximum) {
+			return maximum
+		}
+
+		return time.Duration(result)
+	})
+}
+
+// retryAfterError is implemented by errors that know how long to wait, like errors from rate-limited upstreams
+type retryAfterError interface {
+	error
+	RetryAfter() time.Duration
+}
+
+// UpstreamRetryAfter uses the duration of the first error in the chain that implements `RetryAfter() time.Duration`,
+// such as an error returned by a rate-limited upstream service. If there is none, the fallback is used.
+func UpstreamRetryAfter(fallback RetryAfterStrategy) RetryAfterStrategy {
+	return RetryAfterFunc(func(ctx context.Context, err error) time.Duration {
+		var upstreamErr retryAfterError
+		if errorsAs(err, &upstreamErr) {
+			return upstreamErr.RetryAfter()
+		}
+
+		return fallback.RetryAfter(ctx, err)
+	})
+}
+
+// attemptContextKey is the context key under which the attempt is stored
+type attemptContextKey struct{}
+
+// WithAttempt returns a copy of the context with the attempt count of the client, starting at 0. It's used by
+// ExponentialRetryAfter.
+func WithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptContextKey{}, attempt)
+}
+
+// AttemptFromContext returns the attempt set by WithAttempt, or 0 if none was set.
+func AttemptFromContext(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptContextKey{}).(int)
+
+	return attempt
+}
+
+// FormatRetryAfter formats the duration as the value of a Retry-After header, which is in whole seconds.
+func FormatRetryAfter(duration time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(duration.Seconds())))
+}
+
+// RetryAfterHeader returns headers with the Retry-After set to the duration, for use with
+// RegisterErrorHandlerWithHeadersOn.
+func RetryAfterHeader(duration time.Duration) http.Header {
+	return http.Header{"Retry-After": []string{FormatRetryAfter(duration)}}
+}
@@ -0,0 +1,113 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type rateLimitedError struct {
+	retryAfter time.Duration
+}
+
+func (e *rateLimitedError) Error() string {
+	return "rate limited"
+}
+
+func (e *rateLimitedError) RetryAfter() time.Duration {
+	return e.retryAfter
+}
+
+func TestFixedRetryAfter_ReturnsDuration(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	strategy := FixedRetryAfter(5 * time.Second)
+
+	// Act
+	result := strategy.RetryAfter(WithAttempt(context.Background(), 3), assert.AnError)
+
+	// Assert
+	assert.Equal(t, 5*time.Second, result)
+}
+
+func TestExponentialRetryAfter_ReturnsExpectedDurations(t *testing.T) {
+	t.Parallel()
+	tests := map[int]time.Duration{
+		0: time.Second,
+		1: 2 * time.Second,
+		3: 8 * time.Second,
+		5: 30 * time.Second,
+	}
+
+	strategy := ExponentialRetryAfter(time.Second, 30*time.Second)
+
+	for attempt, expected := range tests {
+		t.Run(fmt.Sprint(attempt), func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := WithAttempt(context.Background(), attempt)
+
+			// Act
+			result := strategy.RetryAfter(ctx, assert.AnError)
+
+			// Assert
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func TestUpstreamRetryAfter_ReturnsUpstreamDuration(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	strategy := UpstreamRetryAfter(FixedRetryAfter(time.Second))
+
+	err := fmt.Errorf("calling upstream: %w", &rateLimitedError{retryAfter: time.Minute})
+
+	// Act
+	result := strategy.RetryAfter(context.Background(), err)
+
+	// Assert
+	assert.Equal(t, time.Minute, result)
+}
+
+func TestUpstreamRetryAfter_ReturnsFallbackOnNoUpstreamDuration(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	strategy := UpstreamRetryAfter(FixedRetryAfter(time.Second))
+
+	// Act
+	result := strategy.RetryAfter(context.Background(), assert.AnError)
+
+	// Assert
+	assert.Equal(t, time.Second, result)
+}
+
+func TestAttemptFromContext_ReturnsZeroOnNoAttempt(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := AttemptFromContext(context.Background())
+
+	// Assert
+	assert.Equal(t, 0, result)
+}
+
+func TestFormatRetryAfter_RoundsUpToSeconds(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := FormatRetryAfter(1500 * time.Millisecond)
+
+	// Assert
+	assert.Equal(t, "2", result)
+}
+
+func TestRetryAfterHeader_SetsRetryAfterInSeconds(t *testing.T) {
+	t.Parallel()
+	// Act
+	result := RetryAfterHeader(1500 * time.Millisecond)
+
+	// Assert
+	assert.Equal(t, "2", result.Get("Retry-After"))
+}
@@ -0,0 +1,98 @@
+package ginerr
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+var (
+	// ErrUpstreamTimeout is returned by the RoundTripper of NewRoundTripper if an outbound call timed out
+	ErrUpstreamTimeout = errors.New("upstream timed out")
+
+	// ErrUpstreamUnavailable is returned by the RoundTripper of NewRoundTripper if an upstream could not be
+	// reached, for example because DNS resolution failed or the connection was refused
+	ErrUpstreamUnavailable = errors.New("upstream unavailable")
+
+	// ErrUpstreamTLS is returned by the RoundTripper of NewRoundTripper if the TLS handshake with an upstream failed
+	ErrUpstreamTLS = errors.New("upstream tls handshake failed")
+)
+
+// roundTripperFunc allows ordinary functions to be used as a http.RoundTripper
+type roundTripperFunc func(request *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+// NewRoundTripper decorates a http.RoundTripper so that transport-level failures of outbound calls are translated
+// into registrable errors, using TranslateTransportError if translate is nil. Combined with
+// UseDefaultUpstreamHandlers, connectivity problems with upstreams get consistent 502/503/504 responses.
+func NewRoundTripper(next http.RoundTripper, translate func(err error) error) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	if translate == nil {
+		translate = TranslateTransportError
+	}
+
+	return roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+		response, err := next.RoundTrip(request)
+		if err != nil {
+			return response, translate(err)
+		}
+
+		return response, nil
+	})
+}
+
+// TranslateTransportError wraps transport errors in ErrUpstreamTimeout, ErrUpstreamUnavailable or ErrUpstreamTLS,
+// the original error is kept in the chain. Other errors, like a cancelled context, are returned as-is.
+func TranslateTransportError(err error) error {
+	var (
+		netErr          net.Error
+		dnsErr          *net.DNSError
+		opErr           *net.OpError
+		recordErr       tls.RecordHeaderError
+		verificationErr *tls.CertificateVerificationError
+		authorityErr    x509.UnknownAuthorityError
+		hostnameErr     x509.HostnameError
+		certificateErr  x509.CertificateInvalidError
+	)
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return err
+
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return fmt.Errorf("%w: %w", ErrUpstreamTimeout, err)
+
+	case errors.As(err, &recordErr), errors.As(err, &verificationErr), errors.As(err, &authorityErr),
+		errors.As(err, &hostnameErr), errors.As(err, &certificateErr):
+		return fmt.Errorf("%w: %w", ErrUpstreamTLS, err)
+
+	case errors.As(err, &dnsErr), errors.As(err, &opErr):
+		return fmt.Errorf("%w: %w", ErrUpstreamUnavailable, err)
+	}
+
+	return err
+}
+
+// UseDefaultUpstreamHandlers registers handlers for the errors of TranslateTransportError in the given registry,
+// timeouts become 504 Gateway Timeout, unreachable upstreams 503 Service Unavailable and TLS failures 502 Bad Gateway.
+func UseDefaultUpstreamHandlers(registry *ErrorRegistry) {
+	RegisterErrorHandlerOn(registry, ErrUpstreamTimeout, func(context.Context, error) (int, any) {
+		return http.StatusGatewayTimeout, nil
+	})
+	RegisterErrorHandlerOn(registry, ErrUpstreamUnavailable, func(context.Context, error) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+	RegisterErrorHandlerOn(registry, ErrUpstreamTLS, func(context.Context, error) (int, any) {
+		return http.StatusBadGateway, nil
+	})
+}
@@ -0,0 +1,146 @@
+package ginerr
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTranslateTransportError_ReturnsExpectedErrors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		err      error
+		expected error
+	}{
+		"deadline":  {err: context.DeadlineExceeded, expected: ErrUpstreamTimeout},
+		"timeout":   {err: &net.DNSError{IsTimeout: true}, expected: ErrUpstreamTimeout},
+		"dns":       {err: &net.DNSError{Err: "no such host"}, expected: ErrUpstreamUnavailable},
+		"refused":   {err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, expected: ErrUpstreamUnavailable},
+		"authority": {err: x509.UnknownAuthorityError{}, expected: ErrUpstreamTLS},
+		"hostname":  {err: x509.HostnameError{Certificate: &x509.Certificate{}}, expected: ErrUpstreamTLS},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := TranslateTransportError(testData.err)
+
+			// Assert
+			require.ErrorIs(t, result, testData.expected)
+			assert.ErrorIs(t, result, testData.err)
+		})
+	}
+}
+
+func TestTranslateTransportError_ReturnsOtherErrorsAsIs(t *testing.T) {
+	t.Parallel()
+	// Act
+	canceled := TranslateTransportError(context.Canceled)
+	other := TranslateTransportError(assert.AnError)
+
+	// Assert
+	assert.Equal(t, context.Canceled, canceled)
+	assert.Equal(t, assert.AnError, other)
+}
+
+func TestNewRoundTripper_TranslatesTimeouts(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewRoundTripper(nil, nil)}
+
+	registry := NewErrorRegistry()
+	UseDefaultUpstreamHandlers(registry)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, http.NoBody)
+	require.NoError(t, err)
+
+	// Act
+	response, err := client.Do(request)
+	if response != nil {
+		_ = response.Body.Close()
+	}
+
+	code, _ := NewErrorResponseFrom(context.Background(), registry, err)
+
+	// Assert
+	require.ErrorIs(t, err, ErrUpstreamTimeout)
+	assert.Equal(t, http.StatusGatewayTimeout, code)
+}
+
+func TestNewRoundTripper_UsesCustomTranslator(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	next := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, assert.AnError
+	})
+
+	errTranslated := errors.New("translated")
+	roundTripper := NewRoundTripper(next, func(error) error {
+		return errTranslated
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	response, err := roundTripper.RoundTrip(request)
+
+	// Assert
+	assert.Nil(t, response)
+	assert.Equal(t, errTranslated, err)
+}
+
+func TestNewRoundTripper_ReturnsResponseOnNoError(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	expected := &http.Response{StatusCode: http.StatusOK}
+	next := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return expected, nil
+	})
+
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	response, err := NewRoundTripper(next, nil).RoundTrip(request)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, expected, response)
+}
+
+func TestUseDefaultUpstreamHandlers_RegistersExpectedStatuses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	UseDefaultUpstreamHandlers(registry)
+
+	tests := map[error]int{
+		ErrUpstreamTimeout:     http.StatusGatewayTimeout,
+		ErrUpstreamUnavailable: http.StatusServiceUnavailable,
+		ErrUpstreamTLS:         http.StatusBadGateway,
+	}
+
+	for err, expected := range tests {
+		// Act
+		code, _ := NewErrorResponseFrom(context.Background(), registry, TranslateTransportError(err))
+
+		// Assert
+		assert.Equal(t, expected, code)
+	}
+}
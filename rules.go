@@ -0,0 +1,142 @@
+package ginerr
+
+import (
+	"cmp"
+	"reflect"
+	"slices"
+)
+
+// MatcherKind is the kind of matching a registration uses.
+type MatcherKind int
+
+const (
+	// MatchString matches a specific error created by errors.New or fmt.Errorf, using errors.Is
+	MatchString MatcherKind = iota
+
+	// MatchType matches errors of a concrete type, using errors.As
+	MatchType
+
+	// MatchInterface matches errors that implement an interface, using errors.As
+	MatchInterface
+
+	// MatchPredicate matches errors using a predicate, see RegisterMatcherHandlerOn and RegisterCodeHandlerOn
+	MatchPredicate
+)
+
+// String returns the name of the kind, like "string".
+func (k MatcherKind) String() string {
+	switch k {
+	case MatchString:
+		return "string"
+	case MatchType:
+		return "type"
+	case MatchInterface:
+		return "interface"
+	case MatchPredicate:
+		return "predicate"
+	default:
+		return "unknown"
+	}
+}
+
+// Rule is a registration in the order it's evaluated, see Rules.
+type Rule struct {
+	HandlerInfo
+
+	// Kind is the kind of matching the registration uses
+	Kind MatcherKind
+}
+
+// SetMatcherOrder sets the order in which the kinds of registrations are evaluated for every error in the tree,
+// for example MatchString, MatchType, MatchInterface to let specific errors win over interfaces that they also
+// implement. Kinds that are left out are evaluated last. Registrations of the same kind are evaluated in the
+// order they were registered. By default, all registrations are evaluated in the order they were registered.
+// The priority of registrations (see WithPriority) takes precedence over their kind.
+func (e *ErrorRegistry) SetMatcherOrder(kinds ...MatcherKind) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.matcherOrder = kinds
+	e.updateEvaluation()
+}
+
+// Rules returns the registrations in the order they are evaluated for every error in the tree of a resolved error,
+// the first rule that matches an error is used. Fallbacks are last, they're only used if no other rule matches any
+// error in the tree.
+func (e *ErrorRegistry) Rules() []Rule {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	rules := make([]Rule, 0, len(e.evaluation))
+
+	for _, key := range e.evaluation {
+		handler := e.handlers[key]
+
+		rules = append(rules, Rule{HandlerInfo: handlerInfo(key, handler), Kind: handler.kind()})
+	}
+
+	return rules
+}
+
+// kind returns the kind of matching the handler uses.
+func (h *errorHandler) kind() MatcherKind {
+	switch {
+	case h.isStringError:
+		return MatchString
+	case h.isMatcher:
+		return MatchPredicate
+	case h.errorType.Kind() == reflect.Interface:
+		return MatchInterface
+	default:
+		return MatchType
+	}
+}
+
+// updateEvaluation sorts the registrations in the order they are evaluated, the caller must hold the lock.
+func (e *ErrorRegistry) updateEvaluation() {
+	reordered := slices.ContainsFunc(e.order, func(key error) bool {
+		return e.handlers[key].priority != 0 || e.handlers[key].fallback
+	})
+
+	// The evaluation is only read, so it can share the registration order
+	if len(e.matcherOrder) == 0 && !reordered {
+		e.evaluation = e.order
+
+		return
+	}
+
+	rank := func(key error) int {
+		index := slices.Index(e.matcherOrder, e.handlers[key].kind())
+		if index < 0 {
+			return len(e.matcherOrder)
+		}
+
+		return index
+	}
+
+	e.evaluation = slices.Clone(e.order)
+
+	slices.SortStableFunc(e.evaluation, func(a error, b error) int {
+		if fallback := compareFallback(e.handlers[a], e.handlers[b]); fallback != 0 {
+			return fallback
+		}
+
+		if priorityA, priorityB := e.handlers[a].priority, e.handlers[b].priority; priorityA != priorityB {
+			return cmp.Compare(priorityB, priorityA)
+		}
+
+		return rank(a) - rank(b)
+	})
+}
+
+// compareFallback sorts fallbacks after other handlers, see RegisterPackageHandlerOn.
+func compareFallback(a *errorHandler, b *errorHandler) int {
+	switch {
+	case a.fallback == b.fallback:
+		return 0
+	case a.fallback:
+		return 1
+	default:
+		return -1
+	}
+}
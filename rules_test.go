@@ -0,0 +1,108 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorRegistry_SetMatcherOrder_ChangesWhichHandlerWins(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		order        []MatcherKind
+		expectedCode int
+	}{
+		"registration order": {
+			expectedCode: http.StatusServiceUnavailable,
+		},
+		"type first": {
+			order:        []MatcherKind{MatchType, MatchInterface},
+			expectedCode: http.StatusTooManyRequests,
+		},
+		"left out kinds last": {
+			order:        []MatcherKind{MatchType},
+			expectedCode: http.StatusTooManyRequests,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+			registry.SetMatcherOrder(testData.order...)
+
+			RegisterTypeOn(registry, func(context.Context, temporaryError) (int, any) {
+				return http.StatusServiceUnavailable, nil
+			})
+
+			RegisterTypeOn(registry, func(context.Context, dummyTemporaryError) (int, any) {
+				return http.StatusTooManyRequests, nil
+			})
+
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, dummyTemporaryError{})
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
+
+func TestErrorRegistry_Rules_ReturnsEvaluationOrder(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+
+	RegisterTypeOn(registry, func(context.Context, temporaryError) (int, any) {
+		return http.StatusServiceUnavailable, nil
+	})
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterErrorHandlerOn(registry, errNotFound, func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	registry.SetMatcherOrder(MatchString, MatchType, MatchInterface)
+
+	// Act
+	result := registry.Rules()
+
+	// Assert
+	expected := []Rule{
+		{HandlerInfo: HandlerInfo{Type: reflect.TypeFor[error](), Error: errNotFound, IsStringError: true}, Kind: MatchString},
+		{HandlerInfo: HandlerInfo{Type: reflect.TypeFor[*AError](), Error: &AError{}}, Kind: MatchType},
+		{HandlerInfo: HandlerInfo{Type: reflect.TypeFor[temporaryError]()}, Kind: MatchInterface},
+	}
+
+	assert.Equal(t, expected, result)
+}
+
+func TestMatcherKind_String_ReturnsName(t *testing.T) {
+	t.Parallel()
+	tests := map[MatcherKind]string{
+		MatchString:     "string",
+		MatchType:       "type",
+		MatchInterface:  "interface",
+		MatchPredicate:  "predicate",
+		MatcherKind(42): "unknown",
+	}
+
+	for kind, expected := range tests {
+		t.Run(expected, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := kind.String()
+
+			// Assert
+			assert.Equal(t, expected, result)
+		})
+	}
+}
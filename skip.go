@@ -0,0 +1,96 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+)
+
+// ErrSkip can be returned as the response of a handler to decline an error after all, resolution then continues with
+// the next handler that matches, like the next registration for the same error or a handler of the error it wraps.
+// This allows conditional handlers, like a handler for database errors that only handles a specific constraint:
+//
+//	ginerr.RegisterErrorHandlerOn(registry, &pgconn.PgError{}, func(_ context.Context, err *pgconn.PgError) (int, any) {
+//		if err.ConstraintName != "users_email_key" {
+//			return 0, ginerr.ErrSkip
+//		}
+//
+//		return http.StatusConflict, "email is already in use"
+//	})
+//
+// The status code returned with ErrSkip is ignored. If every matching handler declines, the default handler is used.
+var ErrSkip = errors.New("skip handler")
+
+// isSkip returns true if the response of a handler declines the error, see ErrSkip.
+func isSkip(response any) bool {
+	err, ok := response.(error)
+
+	return ok && errors.Is(err, ErrSkip)
+}
+
+// resolveSkipped calls the matching handlers after the first one, which declined the error, until one of them
+// doesn't decline. The boolean is false if every handler declined.
+func (e *ErrorRegistry) resolveSkipped(ctx context.Context, err error) (int, any, http.Header, handlerMatch, bool) {
+	candidates := e.candidates(ctx, err)
+
+	// The first candidate is the match that declined, unless it was unregistered in the meantime
+	if len(candidates) == 0 {
+		return 0, nil, nil, handlerMatch{}, false
+	}
+
+	for _, candidate := range candidates[1:] {
+		code, response, headers := candidate.call(ctx)
+		if isSkip(response) {
+			continue
+		}
+
+		return code, response, headers, candidate, true
+	}
+
+	return 0, nil, nil, handlerMatch{}, false
+}
+
+// candidates returns every handler that matches an error in the tree of err, in the order match would have
+// returned them if the previous ones didn't exist. Unlike matches, a handler is returned for every error it matches.
+func (e *ErrorRegistry) candidates(ctx context.Context, err error) []handlerMatch {
+	request := MatchInfoFromContext(ctx)
+
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	var nodes [][]handlerMatch
+
+	root := true
+
+	walkErrors(err, e.unwrapDepth, func(node error) bool {
+		var nodeMatches []handlerMatch
+
+		for _, key := range e.evaluation {
+			if handler := e.handlers[key]; handler.matchesNode(key, node, root, request) {
+				nodeMatches = append(nodeMatches, handlerMatch{key: key, handler: handler, node: node, original: err})
+			}
+		}
+
+		if len(nodeMatches) > 0 {
+			nodes = append(nodes, nodeMatches)
+		}
+
+		root = false
+
+		return false
+	})
+
+	if e.matchPreference == PreferInnermost {
+		slices.Reverse(nodes)
+	}
+
+	result := slices.Concat(nodes...)
+
+	// Fallbacks are only used if nothing else matched
+	slices.SortStableFunc(result, func(a handlerMatch, b handlerMatch) int {
+		return compareFallback(a.handler, b.handler)
+	})
+
+	return result
+}
@@ -0,0 +1,137 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newSkipTestRegistry returns a registry with a conditional handler for AError that only handles "mine"
+func newSkipTestRegistry() *ErrorRegistry {
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(_ context.Context, err *AError) (int, any) {
+		if err.message != "mine" {
+			return http.StatusTeapot, ErrSkip
+		}
+
+		return http.StatusConflict, "mine"
+	})
+
+	return registry
+}
+
+func TestErrSkip_ContinuesWithNextMatch(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newSkipTestRegistry()
+
+	RegisterTypeOn(registry, func(context.Context, *wrappingError) (int, any) {
+		return http.StatusBadGateway, "wrapping"
+	}, WithPriority(-1))
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"not skipped": {
+			err:              &AError{message: "mine"},
+			expectedCode:     http.StatusConflict,
+			expectedResponse: "mine",
+		},
+		"next handler for same error": {
+			err:              &wrappingError{err: &AError{message: "other"}},
+			expectedCode:     http.StatusBadGateway,
+			expectedResponse: "wrapping",
+		},
+		"same handler for wrapped error": {
+			err:              errors.Join(&AError{message: "other"}, &AError{message: "mine"}),
+			expectedCode:     http.StatusConflict,
+			expectedResponse: "mine",
+		},
+		"every handler declined": {
+			err:          fmt.Errorf("wrapped: %w", &AError{message: "other"}),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestErrSkip_ContinuesWithOuterMatchWithInnermost(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := newSkipTestRegistry()
+	registry.SetMatchPreference(PreferInnermost)
+
+	RegisterTypeOn(registry, func(context.Context, *wrappingError) (int, any) {
+		return http.StatusBadGateway, "wrapping"
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, &wrappingError{err: &AError{message: "other"}})
+
+	// Assert
+	assert.Equal(t, http.StatusBadGateway, code)
+	assert.Equal(t, "wrapping", response)
+}
+
+func TestErrSkip_IsLeftOutOfJoinStrategies(t *testing.T) {
+	t.Parallel()
+	tests := map[string]JoinStrategy{
+		"worst status": JoinWorstStatus,
+		"aggregate":    JoinAggregate,
+	}
+
+	for name, strategy := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := newSkipTestRegistry()
+			registry.SetJoinStrategy(strategy)
+
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, &AError{message: "other"})
+
+			// Assert
+			assert.Equal(t, http.StatusInternalServerError, code)
+			assert.Nil(t, response)
+		})
+	}
+}
+
+func TestErrSkip_UsesDefaultHandlerIfHandlerWasUnregistered(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	instance := &AError{}
+
+	RegisterErrorHandlerOn(registry, instance, func(context.Context, *AError) (int, any) {
+		UnregisterErrorHandlerOn(registry, instance)
+
+		return http.StatusTeapot, ErrSkip
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, instance)
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+	assert.Equal(t, Stats{}, registry.Stats())
+}
@@ -0,0 +1,54 @@
+package ginerr
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SSEErrorEvent is the event name of the server-sent events written by NewSSEEvent and WriteSSEError.
+const SSEErrorEvent = "error"
+
+// NewSSEEvent returns an SSE error event for the error using the registry attached to the context or the
+// DefaultErrorRegistry.
+func NewSSEEvent(ctx context.Context, err error) []byte {
+	return NewSSEEventFrom(ctx, registryFromContext(ctx), err)
+}
+
+// NewSSEEventFrom returns an SSE `event: error` frame with the response of the error as data, using the
+// given registry. Strings are used as-is and everything else is marshalled to JSON. The status code is not
+// part of the event, as the status of a stream is sent before the first event.
+func NewSSEEventFrom[E error](ctx context.Context, registry *ErrorRegistry, err E) []byte {
+	_, response := NewErrorResponseFrom(ctx, registry, err)
+
+	var builder strings.Builder
+
+	builder.WriteString("event: " + SSEErrorEvent + "\n")
+
+	// Every line of the data needs its own field, otherwise the line breaks end the event
+	for _, line := range strings.Split(responseText(response), "\n") {
+		builder.WriteString("data: " + line + "\n")
+	}
+
+	builder.WriteString("\n")
+
+	return []byte(builder.String())
+}
+
+// WriteSSEError resolves the error using the registry attached to the request (see WithRegistry) or the
+// DefaultErrorRegistry, and writes it to the stream as an SSE error event.
+func WriteSSEError(c *gin.Context, err error) {
+	WriteSSEErrorFrom(c, registryFromContext(c), err)
+}
+
+// WriteSSEErrorFrom resolves the error using the given registry and writes it to the stream of a gin request as an
+// SSE error event, see NewSSEEventFrom. The stream is flushed, but not closed, so long-lived connections can report
+// failures and continue.
+func WriteSSEErrorFrom(c *gin.Context, registry *ErrorRegistry, err error) {
+	setGinError(c, err)
+
+	_, _ = c.Writer.Write(NewSSEEventFrom(c, registry, err))
+
+	c.Writer.Flush()
+}
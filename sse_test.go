@@ -0,0 +1,78 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/ing-bank/ginerr/v3/respond"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSSEEventFrom_ReturnsErrorEvent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return respond.Conflict("order changed")
+	})
+
+	RegisterErrorHandlerOn(registry, &BError{}, func(context.Context, *BError) (int, any) {
+		return http.StatusBadRequest, "first line\nsecond line"
+	})
+
+	tests := map[string]struct {
+		err      error
+		expected string
+	}{
+		"json": {
+			err:      &AError{},
+			expected: "event: error\ndata: {\"message\":\"order changed\"}\n\n",
+		},
+		"multiline string": {
+			err:      &BError{},
+			expected: "event: error\ndata: first line\ndata: second line\n\n",
+		},
+		"default": {
+			err:      assert.AnError,
+			expected: "event: error\ndata: \n\n",
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := NewSSEEventFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expected, string(result))
+		})
+	}
+}
+
+func TestWriteSSEError_WritesEventToStream(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusServiceUnavailable, "upstream unavailable"
+	})
+
+	engine := newTestEngine(WithRegistry(registry), func(c *gin.Context) {
+		c.SSEvent("message", "hello")
+
+		WriteSSEError(c, &AError{})
+	})
+
+	// Act
+	recorder := serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.True(t, recorder.Flushed)
+	assert.Equal(t, "event:message\ndata:hello\n\nevent: error\ndata: upstream unavailable\n\n", recorder.Body.String())
+}
@@ -0,0 +1,66 @@
+package ginerr
+
+import "context"
+
+// statusCodeHandlerName is the HandlerName of responses of SetStatusCodeBody
+const statusCodeHandlerName = "status code"
+
+// statusCodeError is implemented by errors that know their own status code, like those of many HTTP client libraries
+type statusCodeError interface {
+	StatusCode() int
+}
+
+// httpStatusError is like statusCodeError, with the name some other libraries use
+type httpStatusError interface {
+	HTTPStatus() int
+}
+
+// SetStatusCodeBody enables honoring the status code of errors that no handler matched, if an error anywhere in the
+// tree implements `StatusCode() int` or `HTTPStatus() int`. The registry uses that status code, and body to build
+// the response body from the error that implements it, before the legacy resolver and the default handler are
+// consulted. Status codes outside the range of 100 to 599 are ignored. Nil disables it, which is the default.
+func (e *ErrorRegistry) SetStatusCodeBody(body func(ctx context.Context, err error, code int) any) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.statusCodeBody = body
+}
+
+// resolveStatusCode resolves the error using the status code it reports, the boolean is false if it's disabled or
+// no error in the tree reports a valid status code.
+func (e *ErrorRegistry) resolveStatusCode(ctx context.Context, err error) (int, any, bool) {
+	e.mu.RLock()
+	body := e.statusCodeBody
+	depth := e.unwrapDepth
+	e.mu.RUnlock()
+
+	if body == nil {
+		return 0, nil, false
+	}
+
+	var (
+		code   int
+		source error
+	)
+
+	found := walkErrors(err, depth, func(node error) bool {
+		switch typedErr := node.(type) {
+		case statusCodeError:
+			code = typedErr.StatusCode()
+		case httpStatusError:
+			code = typedErr.HTTPStatus()
+		default:
+			return false
+		}
+
+		source = node
+
+		return code >= 100 && code <= 599
+	})
+
+	if !found {
+		return 0, nil, false
+	}
+
+	return code, body(ctx, source, code), true
+}
@@ -0,0 +1,99 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return http.StatusText(e.code)
+}
+
+func (e *statusError) StatusCode() int {
+	return e.code
+}
+
+type httpStatusCodeError struct {
+	code int
+}
+
+func (e httpStatusCodeError) Error() string {
+	return http.StatusText(e.code)
+}
+
+func (e httpStatusCodeError) HTTPStatus() int {
+	return e.code
+}
+
+func TestErrorRegistry_SetStatusCodeBody_HonorsStatusCodes(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	registry.SetStatusCodeBody(func(_ context.Context, err error, code int) any {
+		return fmt.Sprintf("%d: %s", code, err.Error())
+	})
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "registered"
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"status code": {
+			err:              &statusError{code: http.StatusTooManyRequests},
+			expectedCode:     http.StatusTooManyRequests,
+			expectedResponse: "429: Too Many Requests",
+		},
+		"http status": {
+			err:              fmt.Errorf("calling upstream: %w", httpStatusCodeError{code: http.StatusBadGateway}),
+			expectedCode:     http.StatusBadGateway,
+			expectedResponse: "502: Bad Gateway",
+		},
+		"invalid status code": {
+			err:          &statusError{code: 42},
+			expectedCode: http.StatusInternalServerError,
+		},
+		"registered handler first": {
+			err:              fmt.Errorf("%w: %w", &statusError{code: http.StatusNotFound}, &AError{}),
+			expectedCode:     http.StatusBadRequest,
+			expectedResponse: "registered",
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestErrorRegistry_SetStatusCodeBody_IsDisabledByDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	result := ResolveFrom(context.Background(), registry, &statusError{code: http.StatusNotFound})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, result.Code)
+	assert.Equal(t, "default", result.HandlerName)
+}
@@ -0,0 +1,90 @@
+package ginerr
+
+import (
+	"context"
+	"sync"
+)
+
+// storeContextKey is the context key under which the request store is stored
+type storeContextKey struct{}
+
+// storeGinKey is the key under which the request store is stored in gin contexts
+const storeGinKey = "github.com/ing-bank/ginerr/v3.store"
+
+// storeMu makes sure only one store is created for a gin request, as handlers of the same request might create
+// it concurrently
+var storeMu sync.Mutex
+
+// requestStore holds the values of a single request, handlers of the same request might run concurrently
+type requestStore struct {
+	values sync.Map
+}
+
+// WithStore returns a copy of the context with an empty request-scoped store, which handlers can use to pass
+// computed values, like a parsed upstream body, to later stages without computing them again. Gin requests
+// get a store automatically.
+func WithStore(ctx context.Context) context.Context {
+	return context.WithValue(ctx, storeContextKey{}, &requestStore{})
+}
+
+// StoreValue stores a value under the key in the request-scoped store of the context. Like context keys,
+// keys should be of an unexported type to prevent collisions. It returns false if the context has no store.
+func StoreValue(ctx context.Context, key any, value any) bool {
+	store := storeFromContext(ctx)
+	if store == nil {
+		return false
+	}
+
+	store.values.Store(key, value)
+
+	return true
+}
+
+// LoadValue returns the value stored under the key in the request-scoped store of the context, the boolean
+// is false if there is no store, no value or if the value is not of type T.
+func LoadValue[T any](ctx context.Context, key any) (T, bool) {
+	var result T
+
+	store := storeFromContext(ctx)
+	if store == nil {
+		return result, false
+	}
+
+	value, ok := store.values.Load(key)
+	if !ok {
+		return result, false
+	}
+
+	result, ok = value.(T)
+
+	return result, ok
+}
+
+// storeFromContext returns the store of the context, gin contexts get one the first time it is used.
+func storeFromContext(ctx context.Context) *requestStore {
+	if store, ok := ctx.Value(storeContextKey{}).(*requestStore); ok {
+		return store
+	}
+
+	c := ginContextFrom(ctx)
+	if c == nil {
+		return nil
+	}
+
+	if store, ok := c.Value(storeGinKey).(*requestStore); ok {
+		return store
+	}
+
+	storeMu.Lock()
+	defer storeMu.Unlock()
+
+	// Another handler might have created it while waiting for the lock
+	if store, ok := c.Value(storeGinKey).(*requestStore); ok {
+		return store
+	}
+
+	store := &requestStore{}
+	c.Set(storeGinKey, store)
+
+	return store
+}
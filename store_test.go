@@ -0,0 +1,117 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type storeTestKey struct{}
+
+func TestStoreValue_PassesValuesWithinRequest(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := WithStore(context.Background())
+
+	// Act
+	ok := StoreValue(ctx, storeTestKey{}, "parsed body")
+	result, found := LoadValue[string](ctx, storeTestKey{})
+
+	// Assert
+	assert.True(t, ok)
+	assert.True(t, found)
+	assert.Equal(t, "parsed body", result)
+}
+
+func TestStoreValue_ReturnsFalseOnNoStore(t *testing.T) {
+	t.Parallel()
+	// Act
+	ok := StoreValue(context.Background(), storeTestKey{}, "parsed body")
+	result, found := LoadValue[string](context.Background(), storeTestKey{})
+
+	// Assert
+	assert.False(t, ok)
+	assert.False(t, found)
+	assert.Empty(t, result)
+}
+
+func TestLoadValue_ReturnsFalseOnOtherType(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := WithStore(context.Background())
+	StoreValue(ctx, storeTestKey{}, 123)
+
+	// Act
+	result, found := LoadValue[string](ctx, storeTestKey{})
+
+	// Assert
+	assert.False(t, found)
+	assert.Empty(t, result)
+}
+
+func TestStoreValue_UsesStoreOfGinRequest(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(ctx context.Context, _ *AError) (int, any) {
+		StoreValue(ctx, storeTestKey{}, "from handler")
+
+		return http.StatusBadRequest, nil
+	})
+
+	var result string
+
+	engine := newTestEngine(func(c *gin.Context) {
+		c.Next()
+
+		result, _ = LoadValue[string](c, storeTestKey{})
+	}, WrapHandlerFrom(registry, func(*gin.Context) error {
+		return &AError{}
+	}))
+
+	// Act
+	serveTestRequest(engine)
+
+	// Assert
+	assert.Equal(t, "from handler", result)
+}
+
+func TestStoreValue_KeepsConcurrentValuesOfGinRequest(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+	var wg sync.WaitGroup
+
+	start := make(chan struct{})
+
+	for i := range 50 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			<-start
+			StoreValue(c, i, i)
+		}()
+	}
+
+	// Act
+	close(start)
+	wg.Wait()
+
+	// Assert
+	for i := range 50 {
+		value, ok := LoadValue[int](c, i)
+
+		assert.True(t, ok, i)
+		assert.Equal(t, i, value, i)
+	}
+}
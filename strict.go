@@ -0,0 +1,55 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+)
+
+// UnmappedErrorHeader is set on responses to unmapped errors in strict mode, it contains the type of the error.
+const UnmappedErrorHeader = "X-Ginerr-Unmapped-Error"
+
+// UnmappedErrorResponse is the response to errors that no handler matched in strict mode, see SetStrictMode.
+type UnmappedErrorResponse struct {
+	Error string `json:"error"`
+	Type  string `json:"type"`
+}
+
+// SetStrictMode enables or disables strict mode, which is meant for staging and CI environments. In strict mode,
+// errors that no handler or legacy resolver matched are logged at the error level with a stack trace, and
+// resolved into a 500 with an UnmappedErrorResponse and the UnmappedErrorHeader instead of the response of the
+// default handler, so gaps in the registrations are impossible to miss before production. Don't enable it in
+// production, the response exposes the type of the error.
+func (e *ErrorRegistry) SetStrictMode(enabled bool) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.strict = enabled
+}
+
+// resolveUnmapped returns the strict mode response for an error no handler matched, the boolean is false if strict
+// mode is disabled.
+func (e *ErrorRegistry) resolveUnmapped(ctx context.Context, err error) (int, any, http.Header, bool) {
+	e.mu.RLock()
+	strict := e.strict
+	e.mu.RUnlock()
+
+	if !strict {
+		return 0, nil, nil, false
+	}
+
+	errorType := fmt.Sprintf("%T", err)
+
+	slog.ErrorContext(ctx, "ginerr: no handler registered for error",
+		slog.Any("error", err),
+		slog.String("type", errorType),
+		slog.String("stack", string(debug.Stack())),
+	)
+
+	headers := http.Header{}
+	headers.Set(UnmappedErrorHeader, errorType)
+
+	return http.StatusInternalServerError, UnmappedErrorResponse{Error: "unmapped error", Type: errorType}, headers, true
+}
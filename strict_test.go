@@ -0,0 +1,77 @@
+package ginerr
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//nolint:paralleltest // Can't be used, we change the default logger
+func TestErrorRegistry_SetStrictMode_RespondsDistinctivelyToUnmappedErrors(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+
+	defaultLogger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buffer, nil)))
+
+	defer slog.SetDefault(defaultLogger)
+
+	registry := NewErrorRegistry()
+	registry.SetStrictMode(true)
+
+	// Act
+	code, response, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, &AError{message: "unmapped"})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Equal(t, UnmappedErrorResponse{Error: "unmapped error", Type: "*ginerr.AError"}, response)
+	assert.Equal(t, "*ginerr.AError", headers.Get(UnmappedErrorHeader))
+
+	lines := decodeLogLines(t, &buffer)
+	require.Len(t, lines, 1)
+
+	assert.Equal(t, "ERROR", lines[0]["level"])
+	assert.Equal(t, "ginerr: no handler registered for error", lines[0]["msg"])
+	assert.Equal(t, "unmapped", lines[0]["error"])
+	assert.Equal(t, "*ginerr.AError", lines[0]["type"])
+	assert.Contains(t, lines[0]["stack"], "runtime/debug.Stack")
+}
+
+func TestErrorRegistry_SetStrictMode_KeepsMappedErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetStrictMode(true)
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, "bad request"
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.Equal(t, "bad request", response)
+}
+
+func TestErrorRegistry_SetStrictMode_UsesDefaultHandlerWhenDisabled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetStrictMode(true)
+	registry.SetStrictMode(false)
+
+	// Act
+	code, response, headers := NewErrorResponseWithHeadersFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+	assert.Nil(t, headers)
+}
@@ -0,0 +1,33 @@
+package ginerr
+
+import (
+	"context"
+	"fmt"
+)
+
+// typeNameKey is the key of handlers registered for the name of a type, see RegisterCustomErrorTypeHandlerOn
+type typeNameKey struct {
+	name string
+}
+
+func (t typeNameKey) Error() string {
+	return "type " + t.name
+}
+
+// RegisterCustomErrorTypeHandler registers an error handler for errors whose type has the given name in
+// DefaultErrorRegistry.
+func RegisterCustomErrorTypeHandler(errorType string, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	RegisterCustomErrorTypeHandlerOn(DefaultErrorRegistry, errorType, handler, options...)
+}
+
+// RegisterCustomErrorTypeHandlerOn registers an error handler in the given registry for errors whose type has the
+// given name, as formatted by %T, like "*errors.errorString" or "*url.Error". It's meant for unexported types of
+// third-party libraries that can't be registered with RegisterErrorHandlerOn. Like other registrations, it also
+// matches wrapped errors, the handler receives the error with the type.
+func RegisterCustomErrorTypeHandlerOn(registry *ErrorRegistry, errorType string, handler func(context.Context, error) (int, any), options ...RegistrationOption) {
+	matches := func(err error) bool {
+		return fmt.Sprintf("%T", err) == errorType
+	}
+
+	registry.addHandler(typeNameKey{name: errorType}, newMatcherHandler(matches, handler, options))
+}
@@ -0,0 +1,72 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterCustomErrorTypeHandlerOn_MatchesTypeName(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterCustomErrorTypeHandlerOn(registry, "*url.Error", func(_ context.Context, err error) (int, any) {
+		return http.StatusBadGateway, fmt.Sprintf("%T", err)
+	})
+
+	tests := map[string]struct {
+		err              error
+		expectedCode     int
+		expectedResponse any
+	}{
+		"type": {
+			err:              &url.Error{Op: "Get", URL: "http://example.com", Err: assert.AnError},
+			expectedCode:     http.StatusBadGateway,
+			expectedResponse: "*url.Error",
+		},
+		"wrapped type": {
+			err:              fmt.Errorf("fetching: %w", &url.Error{Op: "Get", URL: "http://example.com", Err: assert.AnError}),
+			expectedCode:     http.StatusBadGateway,
+			expectedResponse: "*url.Error",
+		},
+		"other type": {
+			err:          errors.New("other"),
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			code, response := NewErrorResponseFrom(context.Background(), registry, testData.err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+			assert.Equal(t, testData.expectedResponse, response)
+		})
+	}
+}
+
+func TestRegisterCustomErrorTypeHandlerOn_IsDescribedByTypeName(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterCustomErrorTypeHandlerOn(registry, "*url.Error", func(context.Context, error) (int, any) {
+		return http.StatusBadGateway, nil
+	})
+
+	// Act
+	result := ResolveFrom(context.Background(), registry, &url.Error{Err: assert.AnError})
+
+	// Assert
+	assert.Equal(t, "type *url.Error", result.HandlerName)
+	assert.NoError(t, registry.Validate())
+}
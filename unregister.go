@@ -0,0 +1,89 @@
+package ginerr
+
+import (
+	"reflect"
+	"slices"
+)
+
+// UnregisterErrorHandler removes the handler registered for the instance from DefaultErrorRegistry.
+func UnregisterErrorHandler[E error](instance E) bool {
+	return UnregisterErrorHandlerOn(DefaultErrorRegistry, instance)
+}
+
+// UnregisterErrorHandlerOn removes the handler registered for the instance from the given registry, including its
+// API version variants, so long-running services and tests can remove or replace registrations. The boolean is false
+// if no handler was registered for the instance.
+func UnregisterErrorHandlerOn[E error](registry *ErrorRegistry, instance E) bool {
+	key := registrationKey(instance)
+
+	return registry.removeHandlers(func(errConcrete error, _ *errorHandler) bool {
+		return errConcrete == key
+	})
+}
+
+// UnregisterType removes the handler registered for the error type E from DefaultErrorRegistry.
+func UnregisterType[E error]() bool {
+	return UnregisterTypeOn[E](DefaultErrorRegistry)
+}
+
+// UnregisterTypeOn removes the handler registered for the error type E with RegisterTypeOn from the given registry,
+// like UnregisterErrorHandlerOn. Handlers registered for instances of E are kept. The boolean is false if no handler
+// was registered for E.
+func UnregisterTypeOn[E error](registry *ErrorRegistry) bool {
+	key := typeKey{reflect.TypeFor[E]()}
+
+	return registry.removeHandlers(func(errConcrete error, _ *errorHandler) bool {
+		return errConcrete == key
+	})
+}
+
+// UnregisterString removes the handlers registered for string errors with the message from DefaultErrorRegistry.
+func UnregisterString(message string) bool {
+	return UnregisterStringOn(DefaultErrorRegistry, message)
+}
+
+// UnregisterStringOn removes the handlers registered for string errors created by errors.New or fmt.Errorf with the
+// message from the given registry, like UnregisterErrorHandlerOn. This way they can be removed without access to the
+// instance. The boolean is false if no handler was registered for the message.
+func UnregisterStringOn(registry *ErrorRegistry, message string) bool {
+	return registry.removeHandlers(func(errConcrete error, handler *errorHandler) bool {
+		return handler.isStringError && errConcrete.Error() == message
+	})
+}
+
+// removeHandlers removes every registration the predicate returns true for, the boolean is false if there were none.
+func (e *ErrorRegistry) removeHandlers(remove func(errConcrete error, handler *errorHandler) bool) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	removed := false
+
+	for errConcrete, handler := range e.handlers {
+		if !remove(errConcrete, handler) {
+			continue
+		}
+
+		if handler.isStringError {
+			e.stringErrors--
+		}
+
+		delete(e.handlers, errConcrete)
+
+		removed = true
+	}
+
+	if !removed {
+		return false
+	}
+
+	// The evaluation might share the order, so it's copied instead of modified
+	e.order = slices.DeleteFunc(slices.Clone(e.order), func(errConcrete error) bool {
+		_, ok := e.handlers[errConcrete]
+
+		return !ok
+	})
+
+	e.updateEvaluation()
+
+	return true
+}
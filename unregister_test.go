@@ -0,0 +1,123 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnregisterErrorHandlerOn_RemovesRegistration(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	aErr := &AError{}
+
+	RegisterErrorHandlerOn(registry, aErr, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	result := UnregisterErrorHandlerOn(registry, aErr)
+
+	// Assert
+	assert.True(t, result)
+
+	code, _ := NewErrorResponseFrom(context.Background(), registry, aErr)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Empty(t, registry.Rules())
+}
+
+func TestUnregisterTypeOn_RemovesRegistration(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	aErr := &AError{}
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterErrorHandlerOn(registry, errors.New("not found"), func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	result := UnregisterTypeOn[*AError](registry)
+
+	// Assert
+	assert.True(t, result)
+
+	code, _ := NewErrorResponseFrom(context.Background(), registry, aErr)
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Len(t, registry.Rules(), 1)
+}
+
+func TestUnregisterStringOn_RemovesRegistrationsWithMessage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	errNotFound := errors.New("not found")
+	otherNotFound := errors.New("not found")
+	errConflict := errors.New("conflict")
+
+	for _, err := range []error{errNotFound, otherNotFound, errConflict} {
+		RegisterErrorHandlerOn(registry, err, func(context.Context, error) (int, any) {
+			return http.StatusNotFound, nil
+		})
+	}
+
+	// Act
+	result := UnregisterStringOn(registry, "not found")
+
+	// Assert
+	assert.True(t, result)
+	assert.Equal(t, Stats{Handlers: 1, StringErrors: 1}, registry.Stats())
+
+	code, _ := NewErrorResponseFrom(context.Background(), registry, errNotFound)
+	assert.Equal(t, http.StatusInternalServerError, code)
+}
+
+func TestUnregisterErrorHandlerOn_ReturnsFalseIfNotRegistered(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, errors.New("not found"), func(context.Context, error) (int, any) {
+		return http.StatusNotFound, nil
+	})
+
+	// Act
+	result := UnregisterErrorHandlerOn(registry, &AError{})
+
+	// Assert
+	assert.False(t, result)
+	assert.Len(t, registry.Rules(), 1)
+}
+
+func TestUnregisterTypeOn_AllowsReplacingRegistration(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.SetConflictPolicy(ConflictReject)
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	UnregisterTypeOn[*AError](registry)
+
+	RegisterTypeOn(registry, func(context.Context, *AError) (int, any) {
+		return http.StatusConflict, nil
+	})
+
+	// Assert
+	code, _ := NewErrorResponseFrom(context.Background(), registry, &AError{})
+	assert.Equal(t, http.StatusConflict, code)
+	assert.NoError(t, registry.Validate())
+}
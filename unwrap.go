@@ -0,0 +1,109 @@
+package ginerr
+
+import "reflect"
+
+const (
+	// maxUnwrapDepth is the default maximum depth of the error tree that is searched for matches, it protects
+	// against errors whose Unwrap method keeps returning new errors. See SetMaxUnwrapDepth.
+	maxUnwrapDepth = 100
+
+	// maxUnwrapErrors is the maximum amount of errors that are visited in a single search, it protects against
+	// error trees that branch out (errors.Join) into the same errors over and over again.
+	maxUnwrapErrors = 10_000
+)
+
+// walkErrors calls visit on every error in the tree of err in the same depth-first order as errors.Is and errors.As,
+// until visit returns true. Unlike the errors package it stops on cycles, such as errors that return themselves
+// from Unwrap, and when maxDepth or maxUnwrapErrors is reached. The error itself is at depth 0.
+func walkErrors(err error, maxDepth int, visit func(err error) bool) bool {
+	// Pointers are always comparable, so we can keep track of them to detect cycles. Other types
+	// might panic when used as a map key, those are stopped by the limits instead.
+	seen := make(map[error]struct{})
+	visited := 0
+
+	var walk func(err error, depth int) bool
+	walk = func(err error, depth int) bool {
+		if err == nil || depth > maxDepth || visited >= maxUnwrapErrors {
+			return false
+		}
+
+		if reflect.TypeOf(err).Kind() == reflect.Pointer {
+			if _, ok := seen[err]; ok {
+				return false
+			}
+
+			seen[err] = struct{}{}
+		}
+
+		visited++
+
+		if visit(err) {
+			return true
+		}
+
+		switch unwrapper := err.(type) {
+		case interface{ Unwrap() error }:
+			return walk(unwrapper.Unwrap(), depth+1)
+
+		case interface{ Unwrap() []error }:
+			for _, child := range unwrapper.Unwrap() {
+				if walk(child, depth+1) {
+					return true
+				}
+			}
+		}
+
+		return false
+	}
+
+	return walk(err, 0)
+}
+
+// errorsIs works like errors.Is, but is protected against cyclic error trees by walkErrors.
+func errorsIs(err error, target error) bool {
+	if err == nil || target == nil {
+		return err == target
+	}
+
+	isComparable := reflect.ValueOf(target).Comparable()
+
+	return walkErrors(err, maxUnwrapDepth, func(err error) bool {
+		if isComparable && err == target {
+			return true
+		}
+
+		if matcher, ok := err.(interface{ Is(error) bool }); ok && matcher.Is(target) {
+			return true
+		}
+
+		return false
+	})
+}
+
+// errorsAs works like errors.As, but is protected against cyclic error trees by walkErrors.
+func errorsAs[E any](err error, target *E) bool {
+	return walkErrors(err, maxUnwrapDepth, func(err error) bool {
+		if typedErr, ok := err.(E); ok {
+			*target = typedErr
+
+			return true
+		}
+
+		if matcher, ok := err.(interface{ As(any) bool }); ok && matcher.As(target) {
+			return true
+		}
+
+		return false
+	})
+}
+
+// SetMaxUnwrapDepth limits how deep the error tree of a resolved error is searched for matching handlers, so deeply
+// nested or adversarial wrap chains can't cause surprising matches or performance problems. The resolved error
+// itself is at depth 0, so a depth of 1 only matches the error and the errors it wraps directly. The default is
+// 100, negative depths are treated as 0.
+func (e *ErrorRegistry) SetMaxUnwrapDepth(depth int) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.unwrapDepth = max(depth, 0)
+}
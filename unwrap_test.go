@@ -0,0 +1,232 @@
+package ginerr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// selfUnwrapError returns itself from Unwrap, which makes errors.Is and errors.As loop forever
+type selfUnwrapError struct{}
+
+func (e *selfUnwrapError) Error() string {
+	return "self"
+}
+
+func (e *selfUnwrapError) Unwrap() error {
+	return e
+}
+
+// loopError forms a loop with another loopError
+type loopError struct {
+	next *loopError
+}
+
+func (e *loopError) Error() string {
+	return "loop"
+}
+
+func (e *loopError) Unwrap() error {
+	return e.next
+}
+
+// valueLoopError is not a pointer, so it will be stopped by the depth limit instead of the cycle detection
+type valueLoopError struct{}
+
+func (e valueLoopError) Error() string {
+	return "value loop"
+}
+
+func (e valueLoopError) Unwrap() []error {
+	return []error{e, e}
+}
+
+func TestErrorResponseFrom_StopsOnSelfReferencingErrors(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterErrorHandlerOn(registry, errors.New("sentinel"), func(context.Context, error) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, &selfUnwrapError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+}
+
+func TestErrorResponseFrom_StopsOnErrorLoops(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	errA := &loopError{}
+	errB := &loopError{next: errA}
+	errA.next = errB
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", errA))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+}
+
+func TestErrorResponseFrom_StopsOnBranchingValueLoops(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, valueLoopError{})
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, code)
+	assert.Nil(t, response)
+}
+
+func TestErrorResponseFrom_MatchesErrorsInsideLoops(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &loopError{}, func(context.Context, *loopError) (int, any) {
+		return http.StatusConflict, "loop"
+	})
+
+	errA := &loopError{}
+	errA.next = errA
+
+	// Act
+	code, response := NewErrorResponseFrom(context.Background(), registry, fmt.Errorf("wrapped: %w", errA))
+
+	// Assert
+	assert.Equal(t, http.StatusConflict, code)
+	assert.Equal(t, "loop", response)
+}
+
+func TestErrorsIs_BehavesLikeErrorsIs(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sentinel := errors.New("sentinel")
+
+	tests := map[string]error{
+		"plain":   sentinel,
+		"wrapped": fmt.Errorf("a: %w", sentinel),
+		"joined":  errors.Join(assert.AnError, fmt.Errorf("b: %w", sentinel)),
+		"other":   assert.AnError,
+		"nil":     nil,
+	}
+
+	for name, err := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := errorsIs(err, sentinel)
+
+			// Assert
+			assert.Equal(t, errors.Is(err, sentinel), result)
+		})
+	}
+}
+
+func TestErrorsIs_DoesNotCompareUnhashableTargets(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	target := valueWrappingError{err: sliceError{"a"}}
+
+	// Act
+	result := errorsIs(fmt.Errorf("a: %w", valueWrappingError{err: sliceError{"a"}}), target)
+
+	// Assert
+	assert.False(t, result)
+}
+
+func TestErrorsAs_BehavesLikeErrorsAs(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tests := map[string]error{
+		"plain":   &AError{message: "a"},
+		"wrapped": fmt.Errorf("a: %w", &AError{message: "b"}),
+		"joined":  errors.Join(&BError{}, fmt.Errorf("b: %w", &AError{message: "c"})),
+		"other":   &BError{},
+	}
+
+	for name, err := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var expected, result *AError
+
+			// Act
+			ok := errorsAs(err, &result)
+
+			// Assert
+			assert.Equal(t, errors.As(err, &expected), ok)
+			assert.Equal(t, expected, result)
+		})
+	}
+}
+
+func TestErrorRegistry_SetMaxUnwrapDepth_LimitsMatching(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		depth        int
+		expectedCode int
+	}{
+		"root only": {
+			depth:        0,
+			expectedCode: http.StatusInternalServerError,
+		},
+		"too shallow": {
+			depth:        1,
+			expectedCode: http.StatusInternalServerError,
+		},
+		"deep enough": {
+			depth:        2,
+			expectedCode: http.StatusBadRequest,
+		},
+		"negative": {
+			depth:        -1,
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+			registry.SetMaxUnwrapDepth(testData.depth)
+
+			RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+				return http.StatusBadRequest, nil
+			})
+
+			err := fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", &AError{}))
+
+			// Act
+			code, _ := NewErrorResponseFrom(context.Background(), registry, err)
+
+			// Assert
+			assert.Equal(t, testData.expectedCode, code)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package ginerr
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+var (
+	// ErrNilHandler is returned by Validate if a handler was registered as nil
+	ErrNilHandler = errors.New("handler is nil")
+
+	// ErrNoDefaultHandler is returned by Validate if the default handler was set to nil
+	ErrNoDefaultHandler = errors.New("no default handler")
+
+	// ErrDuplicateHandler is returned by Validate if multiple handlers were registered for the same error type,
+	// only one of them will ever be used
+	ErrDuplicateHandler = errors.New("multiple handlers registered for the same type")
+
+	// ErrShadowingHandler is returned by Validate if a handler was registered for the error interface itself, it
+	// matches every error and shadows all other handlers
+	ErrShadowingHandler = errors.New("handler matches every error")
+)
+
+// errorInterfaceType is the type of the error interface itself
+var errorInterfaceType = reflect.TypeFor[error]()
+
+// Validate checks the registry for misconfiguration, meant to be called at startup to fail fast. It returns all
+// problems that were found joined together, including registrations rejected by ConflictReject, or nil if there
+// are none.
+//
+// Validate doesn't call any handler, so the status codes they return aren't checked, use RegisterPolicy to check
+// them at runtime. Duplicate codes aren't reported either, as handlers of RegisterCodeHandlerOn and errors of
+// RegisterRemoteErrorOn are stored by their code: registering a code again replaces the previous registration,
+// unless SetConflictPolicy says otherwise for handlers.
+func (e *ErrorRegistry) Validate() error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	errs := slices.Clone(e.conflicts)
+
+	if e.defaultHandler == nil {
+		errs = append(errs, ErrNoDefaultHandler)
+	}
+
+	typeCount := make(map[string]int)
+
+	for errConcrete, handler := range e.handlers {
+		if handler.isNil {
+			errs = append(errs, fmt.Errorf("%w: %v", ErrNilHandler, describeRegistration(errConcrete, handler)))
+		}
+
+		// String errors share the same type, they are matched on their value. Matchers use their predicate.
+		if handler.isStringError || handler.isMatcher {
+			continue
+		}
+
+		if handler.errorType == errorInterfaceType {
+			errs = append(errs, fmt.Errorf("%w: %v", ErrShadowingHandler, describeRegistration(errConcrete, handler)))
+		}
+
+		typeCount[handler.errorType.String()]++
+	}
+
+	for typeName, count := range typeCount {
+		if count > 1 {
+			errs = append(errs, fmt.Errorf("%w: %d handlers for %s", ErrDuplicateHandler, count, typeName))
+		}
+	}
+
+	// The handlers are stored in a map, sort them to get a deterministic result
+	slices.SortFunc(errs, func(a, b error) int {
+		return strings.Compare(a.Error(), b.Error())
+	})
+
+	return errors.Join(errs...)
+}
+
+// describeRegistration returns a human-readable description of a registration.
+func describeRegistration(errConcrete error, handler *errorHandler) string {
+	if handler.isStringError {
+		return fmt.Sprintf("error %q", errConcrete.Error())
+	}
+
+	if handler.instance != nil {
+		return fmt.Sprintf("error %q", handler.instance.Error())
+	}
+
+	if handler.isMatcher {
+		return errConcrete.Error()
+	}
+
+	return "type " + handler.errorType.String()
+}
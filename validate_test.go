@@ -0,0 +1,154 @@
+package ginerr
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate_ReturnsNilOnValidRegistry(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterErrorHandlerOn(registry, errors.New("a"), func(context.Context, error) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+	RegisterErrorHandlerOn(registry, errors.New("b"), func(context.Context, error) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestValidate_DoesNotCallHandlers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	var called bool
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		called = true
+
+		return 0, nil
+	})
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.False(t, called)
+}
+
+func TestValidate_ReturnsNilOnValueTypes(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Errors like these are returned as values by the standard library
+	RegisterTypeOn(registry, func(context.Context, x509.HostnameError) (int, any) {
+		return http.StatusBadGateway, nil
+	})
+	RegisterErrorHandlerOn(registry, valueError{}, func(context.Context, valueError) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestValidate_ReturnsErrorOnNilHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn[*AError](registry, &AError{}, nil)
+	RegisterErrorHandlerOn(registry, errors.New("a"), nil)
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNilHandler)
+	assert.EqualError(t, err, "handler is nil: error \"a\"\nhandler is nil: type *ginerr.AError")
+}
+
+func TestValidate_ReturnsErrorOnNoDefaultHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.RegisterDefaultHandler(nil)
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNoDefaultHandler)
+}
+
+func TestValidate_ReturnsErrorOnDuplicateHandlers(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	callback := func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, nil
+	}
+
+	RegisterErrorHandlerOn(registry, &AError{}, callback)
+	RegisterTypeOn(registry, callback)
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrDuplicateHandler)
+	assert.EqualError(t, err, "multiple handlers registered for the same type: 2 handlers for *ginerr.AError")
+}
+
+func TestValidate_ReturnsErrorOnShadowingHandler(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, error(&AError{}), func(context.Context, error) (int, any) {
+		return http.StatusBadRequest, nil
+	})
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrShadowingHandler)
+}
+
+func TestValidate_ReturnsAllProblems(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+	registry.RegisterDefaultHandler(nil)
+
+	RegisterErrorHandlerOn[*AError](registry, &AError{}, nil)
+
+	// Act
+	err := registry.Validate()
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNoDefaultHandler)
+	assert.ErrorIs(t, err, ErrNilHandler)
+}
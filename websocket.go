@@ -0,0 +1,103 @@
+package ginerr
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// WebSocket close codes as defined in RFC 6455 section 7.4.1 and the IANA registry.
+const (
+	CloseNormalClosure   = 1000
+	CloseUnsupportedData = 1003
+	ClosePolicyViolation = 1008
+	CloseMessageTooBig   = 1009
+	CloseInternalError   = 1011
+	CloseTryAgainLater   = 1013
+	CloseBadGateway      = 1014
+)
+
+// maxCloseReasonLength is the maximum amount of bytes in a close reason, control frames may only
+// have a payload of 125 bytes and 2 of those are used by the close code.
+const maxCloseReasonLength = 123
+
+// NewCloseFrame returns a WebSocket close code and close frame payload using the registry attached to the context
+// or the DefaultErrorRegistry.
+func NewCloseFrame(ctx context.Context, err error) (int, []byte) {
+	return NewCloseFrameFrom(ctx, registryFromContext(ctx), err)
+}
+
+// NewCloseFrameFrom returns a WebSocket close code and close frame payload using the given registry. The
+// status code of the response is mapped using CloseCodeFromStatus and the response is used as the close reason.
+func NewCloseFrameFrom[E error](ctx context.Context, registry *ErrorRegistry, err E) (int, []byte) {
+	code, response := NewErrorResponseFrom(ctx, registry, err)
+
+	closeCode := CloseCodeFromStatus(code)
+
+	return closeCode, formatClosePayload(closeCode, responseText(response))
+}
+
+// CloseCodeFromStatus maps an HTTP status code to the WebSocket close code that describes it best.
+func CloseCodeFromStatus(status int) int {
+	switch {
+	case status < http.StatusBadRequest:
+		return CloseNormalClosure
+	case status == http.StatusRequestEntityTooLarge:
+		return CloseMessageTooBig
+	case status == http.StatusUnsupportedMediaType:
+		return CloseUnsupportedData
+	case status == http.StatusTooManyRequests, status == http.StatusServiceUnavailable:
+		return CloseTryAgainLater
+	case status == http.StatusBadGateway, status == http.StatusGatewayTimeout:
+		return CloseBadGateway
+	case status < http.StatusInternalServerError:
+		return ClosePolicyViolation
+	default:
+		return CloseInternalError
+	}
+}
+
+// responseText turns a response into a string, strings are used as-is and everything else is marshalled to JSON.
+func responseText(response any) string {
+	switch typedResponse := response.(type) {
+	case nil:
+		return ""
+	case string:
+		return typedResponse
+	case fmt.Stringer:
+		return typedResponse.String()
+	}
+
+	result, err := json.Marshal(response)
+	if err != nil {
+		return ""
+	}
+
+	return string(result)
+}
+
+// formatClosePayload creates the payload of a close frame, consisting of the close code and a reason. Invalid UTF-8
+// in the reason is replaced, as RFC 6455 requires it to be valid, and reasons that are too long are truncated
+// without breaking up UTF-8 characters.
+func formatClosePayload(closeCode int, reason string) []byte {
+	reason = strings.ToValidUTF8(reason, string(utf8.RuneError))
+
+	if len(reason) > maxCloseReasonLength {
+		reason = reason[:maxCloseReasonLength]
+
+		for !utf8.ValidString(reason) {
+			reason = reason[:len(reason)-1]
+		}
+	}
+
+	payload := make([]byte, 2, 2+len(reason))
+
+	//nolint:gosec // Close codes always fit in 16 bits
+	binary.BigEndian.PutUint16(payload, uint16(closeCode))
+
+	return append(payload, reason...)
+}
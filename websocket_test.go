@@ -0,0 +1,147 @@
+package ginerr
+
+import (
+	"context"
+	"encoding/binary"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCloseFrameFrom_ReturnsExpectedCloseCodeAndPayload(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	callback := func(context.Context, *AError) (int, any) {
+		return http.StatusServiceUnavailable, "try again later"
+	}
+
+	RegisterErrorHandlerOn(registry, &AError{}, callback)
+
+	// Act
+	code, payload := NewCloseFrameFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, CloseTryAgainLater, code)
+	assert.Equal(t, uint16(CloseTryAgainLater), binary.BigEndian.Uint16(payload))
+	assert.Equal(t, "try again later", string(payload[2:]))
+}
+
+func TestNewCloseFrameFrom_MarshalsNonStringResponses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	callback := func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, map[string]string{"error": "bad"}
+	}
+
+	RegisterErrorHandlerOn(registry, &AError{}, callback)
+
+	// Act
+	code, payload := NewCloseFrameFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.Equal(t, ClosePolicyViolation, code)
+	assert.JSONEq(t, `{"error":"bad"}`, string(payload[2:]))
+}
+
+func TestNewCloseFrameFrom_ReturnsInternalErrorOnDefault(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	// Act
+	code, payload := NewCloseFrameFrom(context.Background(), registry, assert.AnError)
+
+	// Assert
+	assert.Equal(t, CloseInternalError, code)
+	assert.Len(t, payload, 2)
+}
+
+func TestNewCloseFrameFrom_TruncatesLongReasons(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	callback := func(context.Context, *AError) (int, any) {
+		return http.StatusBadRequest, strings.Repeat("ü", 100)
+	}
+
+	RegisterErrorHandlerOn(registry, &AError{}, callback)
+
+	// Act
+	_, payload := NewCloseFrameFrom(context.Background(), registry, &AError{})
+
+	// Assert
+	assert.LessOrEqual(t, len(payload), 125)
+	assert.True(t, utf8.Valid(payload[2:]))
+	assert.Equal(t, strings.Repeat("ü", 61), string(payload[2:]))
+}
+
+func TestNewCloseFrameFrom_ReplacesInvalidUTF8(t *testing.T) {
+	t.Parallel()
+	tests := map[string]struct {
+		reason   string
+		expected string
+	}{
+		"short": {
+			reason:   "bad \xff byte",
+			expected: "bad \uFFFD byte",
+		},
+		"truncated": {
+			reason:   "\xff" + strings.Repeat("a", 200),
+			expected: "\uFFFD" + strings.Repeat("a", 120),
+		},
+	}
+
+	for name, testData := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			registry := NewErrorRegistry()
+
+			RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+				return http.StatusBadRequest, testData.reason
+			})
+
+			// Act
+			_, payload := NewCloseFrameFrom(context.Background(), registry, &AError{})
+
+			// Assert
+			assert.True(t, utf8.Valid(payload[2:]))
+			assert.Equal(t, testData.expected, string(payload[2:]))
+		})
+	}
+}
+
+func TestCloseCodeFromStatus_ReturnsExpectedCloseCodes(t *testing.T) {
+	t.Parallel()
+	tests := map[int]int{
+		http.StatusOK:                    CloseNormalClosure,
+		http.StatusBadRequest:            ClosePolicyViolation,
+		http.StatusForbidden:             ClosePolicyViolation,
+		http.StatusRequestEntityTooLarge: CloseMessageTooBig,
+		http.StatusUnsupportedMediaType:  CloseUnsupportedData,
+		http.StatusTooManyRequests:       CloseTryAgainLater,
+		http.StatusInternalServerError:   CloseInternalError,
+		http.StatusBadGateway:            CloseBadGateway,
+		http.StatusServiceUnavailable:    CloseTryAgainLater,
+		http.StatusGatewayTimeout:        CloseBadGateway,
+	}
+
+	for status, expected := range tests {
+		t.Run(http.StatusText(status), func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result := CloseCodeFromStatus(status)
+
+			// Assert
+			assert.Equal(t, expected, result)
+		})
+	}
+}
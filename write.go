@@ -0,0 +1,76 @@
+package ginerr
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// WriteError resolves the error using the registry attached to the request context (see ContextWithRegistry) or
+// the DefaultErrorRegistry, and writes the response with WriteResponse.
+func WriteError(w http.ResponseWriter, r *http.Request, err error) error {
+	return WriteErrorFrom(w, r, registryFromContext(r.Context()), err)
+}
+
+// WriteErrorFrom resolves the error using the given registry and writes the response and the headers returned
+// by the handler with WriteResponse. It's the net/http counterpart of AbortWithErrorFrom.
+func WriteErrorFrom(w http.ResponseWriter, r *http.Request, registry *ErrorRegistry, err error) error {
+	code, response, headers := NewErrorResponseWithHeadersFrom(r.Context(), registry, err)
+
+	for key, values := range headers {
+		w.Header().Del(key)
+
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+
+	encoder := registry.getJSONEncoder()
+	if encoder == nil {
+		encoder = defaultJSONEncoder
+	}
+
+	return writeResponse(w, r, code, response, encoder)
+}
+
+// WriteResponse writes an error response, strings are written as plain text and everything else as JSON. The
+// Content-Type and Content-Length are always set, but the body is skipped for HEAD requests so they get the same
+// headers as a GET. Nil responses are written without a body. Nothing is written if the response can't be
+// marshalled, the error is returned instead.
+func WriteResponse(w http.ResponseWriter, r *http.Request, code int, response any) error {
+	return writeResponse(w, r, code, response, defaultJSONEncoder)
+}
+
+// writeResponse writes the response like WriteResponse, using the given encoder for JSON.
+func writeResponse(w http.ResponseWriter, r *http.Request, code int, response any, encoder JSONEncoder) error {
+	if response == nil {
+		w.Header().Set("Content-Length", "0")
+		w.WriteHeader(code)
+
+		return nil
+	}
+
+	contentType := "text/plain; charset=utf-8"
+
+	body, ok := response.(string)
+	if !ok {
+		result, err := encoder.Marshal(response)
+		if err != nil {
+			return err
+		}
+
+		contentType = "application/json; charset=utf-8"
+		body = string(result)
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(code)
+
+	if r.Method == http.MethodHead {
+		return nil
+	}
+
+	_, err := w.Write([]byte(body))
+
+	return err
+}
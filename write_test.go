@@ -0,0 +1,137 @@
+package ginerr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteResponse_WritesJSON(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	err := WriteResponse(recorder, request, http.StatusNotFound, map[string]string{"message": "not found"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "application/json; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "23", recorder.Header().Get("Content-Length"))
+	assert.JSONEq(t, `{"message":"not found"}`, recorder.Body.String())
+}
+
+func TestWriteResponse_WritesStringsAsText(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	err := WriteResponse(recorder, request, http.StatusConflict, "conflict")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "8", recorder.Header().Get("Content-Length"))
+	assert.Equal(t, "conflict", recorder.Body.String())
+}
+
+func TestWriteResponse_SkipsBodyOnHead(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodHead, "/", http.NoBody)
+
+	// Act
+	err := WriteResponse(recorder, request, http.StatusConflict, "conflict")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, recorder.Code)
+	assert.Equal(t, "text/plain; charset=utf-8", recorder.Header().Get("Content-Type"))
+	assert.Equal(t, "8", recorder.Header().Get("Content-Length"))
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestWriteResponse_WritesNoBodyOnNilResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	err := WriteResponse(recorder, request, http.StatusInternalServerError, nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	assert.Equal(t, "0", recorder.Header().Get("Content-Length"))
+	assert.Empty(t, recorder.Body.String())
+}
+
+func TestWriteResponse_ReturnsErrorOnUnmarshallableResponse(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	err := WriteResponse(recorder, request, http.StatusBadRequest, make(chan int))
+
+	// Assert
+	require.Error(t, err)
+	assert.False(t, recorder.Flushed)
+	assert.Empty(t, recorder.Header())
+}
+
+func TestWriteErrorFrom_WritesResponseAndHeaders(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerWithHeadersOn(registry, &AError{}, func(context.Context, *AError) (int, any, http.Header) {
+		return http.StatusUnauthorized, "unauthorized", http.Header{"Www-Authenticate": []string{"Bearer"}}
+	})
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+
+	// Act
+	err := WriteErrorFrom(recorder, request, registry, &AError{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	assert.Equal(t, "Bearer", recorder.Header().Get("WWW-Authenticate"))
+	assert.Equal(t, "unauthorized", recorder.Body.String())
+}
+
+func TestWriteError_UsesRegistryFromContext(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	registry := NewErrorRegistry()
+
+	RegisterErrorHandlerOn(registry, &AError{}, func(context.Context, *AError) (int, any) {
+		return http.StatusTeapot, "teapot"
+	})
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+	request = request.WithContext(ContextWithRegistry(request.Context(), registry))
+
+	// Act
+	err := WriteError(recorder, request, &AError{})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusTeapot, recorder.Code)
+	assert.Equal(t, "teapot", recorder.Body.String())
+}